@@ -6,7 +6,12 @@
 package main
 
 import (
+	"os"
+
 	"github.com/onosproject/device-provisioner/pkg/manager"
+	"github.com/onosproject/device-provisioner/pkg/metrics"
+	"github.com/onosproject/device-provisioner/pkg/store/configs"
+	"github.com/onosproject/device-provisioner/pkg/tracing"
 	"github.com/onosproject/onos-lib-go/pkg/cli"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/onosproject/onos-net-lib/pkg/realm"
@@ -20,6 +25,23 @@ const (
 	defaultTopoAddress = "onos-topo:5150"
 	artifactDirFlag    = "artifact-dir"
 	defaultArtifactDir = "/etc/onos/device-configs"
+
+	artifactBackendFlag = "artifact-backend"
+	s3EndpointFlag      = "artifact-s3-endpoint"
+	s3BucketFlag        = "artifact-s3-bucket"
+	s3PrefixFlag        = "artifact-s3-prefix"
+	s3RegionFlag        = "artifact-s3-region"
+
+	shutdownTimeoutFlag = "shutdown-timeout"
+	metricsAddressFlag  = "metrics-address"
+	tracingEndpointFlag = "tracing-endpoint"
+	tracingInsecureFlag = "tracing-insecure"
+	auditCapacityFlag   = "audit-capacity"
+	revisionsFlag       = "revisions"
+
+	// S3 credentials are taken from the environment to keep them off the command line
+	s3AccessKeyIDEnv     = "AWS_ACCESS_KEY_ID"
+	s3SecretAccessKeyEnv = "AWS_SECRET_ACCESS_KEY"
 )
 
 // The main entry point
@@ -31,13 +53,36 @@ func main() {
 	realm.AddRealmFlags(cmd, "provisioner")
 	cmd.Flags().String(topoAddressFlag, defaultTopoAddress, "address:port or just :port of the onos-topo service")
 	cmd.Flags().String(artifactDirFlag, defaultArtifactDir, "directory where artifact files are maintained")
+	cmd.Flags().String(artifactBackendFlag, configs.DirBackend, "where artifacts are maintained; one of 'dir', 's3' or 'inline' (in Atomix)")
+	cmd.Flags().String(s3EndpointFlag, "", "URL of the S3-compatible object store where artifacts are maintained")
+	cmd.Flags().String(s3BucketFlag, "device-configs", "object store bucket where artifacts are maintained")
+	cmd.Flags().String(s3PrefixFlag, "", "prefix of the object store keys of the artifacts")
+	cmd.Flags().String(s3RegionFlag, "us-east-1", "object store region")
+	cmd.Flags().String(metricsAddressFlag, metrics.DefaultAddress, "address:port or just :port on which the Prometheus metrics are served")
+	cmd.Flags().String(tracingEndpointFlag, "", "address:port of the OTLP gRPC collector to which traces are exported; tracing is disabled if not set")
+	cmd.Flags().Bool(tracingInsecureFlag, false, "if set, do not use TLS for the connection to the OTLP collector")
+	cmd.Flags().Int(auditCapacityFlag, configs.DefaultAuditCapacity, "number of provisioning events retained by the audit log")
+	cmd.Flags().Int(revisionsFlag, configs.DefaultRevisionRetention, "number of revisions retained for each configuration")
+	cmd.Flags().Duration(shutdownTimeoutFlag, manager.DefaultShutdownTimeout, "time allowed for in-flight configuration pushes to complete on shutdown")
 	cli.AddServiceEndpointFlags(cmd, "provisioner gRPC")
+	cmd.AddCommand(getStatusCommand())
 	cli.Run(cmd)
 }
 
 func runRootCommand(cmd *cobra.Command, args []string) error {
 	topoAddress, _ := cmd.Flags().GetString(topoAddressFlag)
 	artifactDir, _ := cmd.Flags().GetString(artifactDirFlag)
+	artifactBackend, _ := cmd.Flags().GetString(artifactBackendFlag)
+	s3Endpoint, _ := cmd.Flags().GetString(s3EndpointFlag)
+	s3Bucket, _ := cmd.Flags().GetString(s3BucketFlag)
+	s3Prefix, _ := cmd.Flags().GetString(s3PrefixFlag)
+	s3Region, _ := cmd.Flags().GetString(s3RegionFlag)
+	shutdownTimeout, _ := cmd.Flags().GetDuration(shutdownTimeoutFlag)
+	metricsAddress, _ := cmd.Flags().GetString(metricsAddressFlag)
+	tracingEndpoint, _ := cmd.Flags().GetString(tracingEndpointFlag)
+	tracingInsecure, _ := cmd.Flags().GetBool(tracingInsecureFlag)
+	auditCapacity, _ := cmd.Flags().GetInt(auditCapacityFlag)
+	revisions, _ := cmd.Flags().GetInt(revisionsFlag)
 	realmOptions := realm.ExtractOptions(cmd)
 
 	flags, err := cli.ExtractServiceEndpointFlags(cmd)
@@ -47,10 +92,27 @@ func runRootCommand(cmd *cobra.Command, args []string) error {
 
 	log.Infof("Starting device-provisioner")
 	cfg := manager.Config{
-		RealmOptions: realmOptions,
-		TopoAddress:  topoAddress,
-		ArtifactDir:  artifactDir,
-		ServiceFlags: flags,
+		RealmOptions:    realmOptions,
+		TopoAddress:     topoAddress,
+		ArtifactBackend: artifactBackend,
+		ArtifactDir:     artifactDir,
+		ObjectStore: configs.ObjectStoreConfig{
+			Endpoint:        s3Endpoint,
+			Bucket:          s3Bucket,
+			Prefix:          s3Prefix,
+			Region:          s3Region,
+			AccessKeyID:     os.Getenv(s3AccessKeyIDEnv),
+			SecretAccessKey: os.Getenv(s3SecretAccessKeyEnv),
+		},
+		ServiceFlags:    flags,
+		ShutdownTimeout: shutdownTimeout,
+		MetricsAddress:  metricsAddress,
+		Tracing: tracing.Options{
+			Endpoint: tracingEndpoint,
+			Insecure: tracingInsecure,
+		},
+		AuditCapacity:     auditCapacity,
+		RevisionRetention: revisions,
 	}
 
 	return cli.RunDaemon(manager.NewManager(cfg))
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	dpapi "github.com/onosproject/device-provisioner/api/deviceprovisioner"
+	"github.com/onosproject/onos-lib-go/pkg/cli"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultServiceAddress = "device-provisioner:5150"
+	watchFlag             = "watch"
+	authHeaderFlag        = "auth-header"
+)
+
+// Returns the command reporting the provisioning status of the devices
+func getStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [device-id]",
+		Short: "Show the provisioning status of the devices in the realm, or of the given device",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runStatusCommand,
+	}
+	cli.AddEndpointFlags(cmd, defaultServiceAddress)
+	cmd.Flags().String(authHeaderFlag, "", "auth header in the form 'Bearer <base64>'")
+	cmd.Flags().BoolP(watchFlag, "w", false, "watch the status of the devices for changes")
+	return cmd
+}
+
+func runStatusCommand(cmd *cobra.Command, args []string) error {
+	watch, _ := cmd.Flags().GetBool(watchFlag)
+	conn, err := cli.GetConnection(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := dpapi.NewDeviceProvisionerServiceClient(conn)
+	ctx := cli.NewContextWithAuthHeaderFromFlag(cmd.Context(), cmd.Flags().Lookup(authHeaderFlag))
+
+	writer := tabwriter.NewWriter(cli.GetOutput(), 0, 0, 2, ' ', 0)
+	printStatusHeader(writer)
+	if len(args) == 1 {
+		response, err := client.GetDeviceStatus(ctx, &dpapi.GetDeviceStatusRequest{Id: args[0]})
+		if err != nil {
+			return err
+		}
+		printDeviceStatus(writer, response.Status, "")
+		return writer.Flush()
+	}
+	if watch {
+		return watchStatus(ctx, client, writer)
+	}
+
+	stream, err := client.ListDeviceStatus(ctx, &dpapi.ListDeviceStatusRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return writer.Flush()
+		}
+		if err != nil {
+			return err
+		}
+		printDeviceStatus(writer, response.Status, "")
+	}
+}
+
+// Prints the status of the devices as it changes, until the stream is closed
+func watchStatus(ctx context.Context, client dpapi.DeviceProvisionerServiceClient, writer *tabwriter.Writer) error {
+	stream, err := client.WatchDeviceStatus(ctx, &dpapi.WatchDeviceStatusRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return writer.Flush()
+		}
+		if err != nil {
+			return err
+		}
+		state := ""
+		if response.Removed {
+			state = "REMOVED"
+		}
+		printDeviceStatus(writer, response.Status, state)
+		if err = writer.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+func printStatusHeader(writer io.Writer) {
+	_, _ = fmt.Fprintln(writer, "DEVICE\tKIND\tDESIRED\tAPPLIED\tSTATE\tATTEMPTS\tLAST ATTEMPT\tLAST SUCCESS\tLAST ERROR")
+}
+
+// Prints a line for the pipeline and the chassis configuration status of the device; the state is overridden
+// by the given one, if any
+func printDeviceStatus(writer io.Writer, status *dpapi.DeviceStatus, state string) {
+	for _, c := range []struct {
+		kind   string
+		status *dpapi.ConfigStatus
+	}{{"pipeline", status.Pipeline}, {"chassis", status.Chassis}} {
+		if c.status == nil || (c.status.DesiredConfigId == "" && c.status.TargetConfigId == "") {
+			continue
+		}
+		configState := state
+		if configState == "" {
+			configState = c.status.State.String()
+			if c.status.GaveUp {
+				configState += " (gave up)"
+			}
+		}
+		lastError := c.status.LastError
+		if lastError != "" && c.status.LastErrorCode != "" {
+			lastError = fmt.Sprintf("%s: %s", c.status.LastErrorCode, lastError)
+		}
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n", status.Id, c.kind,
+			orNone(c.status.DesiredConfigId), orNone(c.status.AppliedConfigId), configState, c.status.Attempts,
+			formatTime(c.status.LastAttempt), formatTime(c.status.LastSuccess), orNone(lastError))
+	}
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+	return ts.AsTime().Local().Format(time.RFC3339)
+}
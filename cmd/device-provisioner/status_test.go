@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	dpapi "github.com/onosproject/device-provisioner/api/deviceprovisioner"
+	"github.com/onosproject/onos-lib-go/pkg/cli"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// columns are padded by at least two spaces, while the values have at most single spaces
+var columnSeparator = regexp.MustCompile(`\s{2,}`)
+
+// Prints the header and the given device statuses, returning the output lines split in their columns
+func printStatus(t *testing.T, state string, statuses ...*dpapi.DeviceStatus) [][]string {
+	buf := &bytes.Buffer{}
+	writer := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+	printStatusHeader(writer)
+	for _, status := range statuses {
+		printDeviceStatus(writer, status, state)
+	}
+	assert.NoError(t, writer.Flush())
+	lines := make([][]string, 0)
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		lines = append(lines, columnSeparator.Split(strings.TrimSpace(line), -1))
+	}
+	return lines
+}
+
+func Test_GetStatusCommand(t *testing.T) {
+	cmd := getStatusCommand()
+	assert.Equal(t, "status", cmd.Name())
+	assert.Error(t, cmd.Args(cmd, []string{"leaf1", "leaf2"}))
+	assert.NoError(t, cmd.Args(cmd, []string{"leaf1"}))
+
+	assert.Equal(t, defaultServiceAddress, cli.GetServiceAddress(cmd))
+	assert.NotNil(t, cmd.Flags().Lookup(authHeaderFlag))
+	assert.NoError(t, cmd.Flags().Parse([]string{"-w"}))
+	watch, err := cmd.Flags().GetBool(watchFlag)
+	assert.NoError(t, err)
+	assert.True(t, watch)
+}
+
+func Test_PrintDeviceStatus(t *testing.T) {
+	lastAttempt := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	lines := printStatus(t, "",
+		&dpapi.DeviceStatus{
+			Id: "leaf1",
+			Pipeline: &dpapi.ConfigStatus{DesiredConfigId: "p4_leaf", TargetConfigId: "p4_leaf@2", AppliedConfigId: "p4_leaf@1",
+				State: dpapi.ConfigStatus_FAILED, Attempts: 3, GaveUp: true, LastError: "connection refused",
+				LastErrorCode: "Unavailable", LastAttempt: timestamppb.New(lastAttempt)},
+			Chassis: &dpapi.ConfigStatus{DesiredConfigId: "ch_leaf", TargetConfigId: "ch_leaf@1", AppliedConfigId: "ch_leaf@1",
+				State: dpapi.ConfigStatus_APPLIED},
+		},
+		// devices without any configuration assigned to are listed without configuration lines
+		&dpapi.DeviceStatus{Id: "leaf2", Pipeline: &dpapi.ConfigStatus{}, Chassis: &dpapi.ConfigStatus{}})
+
+	assert.Len(t, lines, 3)
+	assert.Equal(t, []string{"DEVICE", "KIND", "DESIRED", "APPLIED", "STATE", "ATTEMPTS", "LAST ATTEMPT", "LAST SUCCESS", "LAST ERROR"},
+		lines[0])
+	assert.Equal(t, []string{"leaf1", "pipeline", "p4_leaf", "p4_leaf@1", "FAILED (gave up)", "3",
+		lastAttempt.Local().Format(time.RFC3339), "-", "Unavailable: connection refused"}, lines[1])
+	assert.Equal(t, []string{"leaf1", "chassis", "ch_leaf", "ch_leaf@1", "APPLIED", "0", "-", "-", "-"}, lines[2])
+}
+
+func Test_PrintRemovedDeviceStatus(t *testing.T) {
+	// the state of removed devices is overridden, while a configuration not yet applied is shown as such
+	lines := printStatus(t, "REMOVED", &dpapi.DeviceStatus{Id: "leaf1",
+		Pipeline: &dpapi.ConfigStatus{DesiredConfigId: "p4_leaf", State: dpapi.ConfigStatus_PENDING}})
+	assert.Len(t, lines, 2)
+	assert.Equal(t, []string{"leaf1", "pipeline", "p4_leaf", "-", "REMOVED", "0", "-", "-", "-"}, lines[1])
+}
@@ -7,10 +7,13 @@ package chassis
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	"github.com/onosproject/device-provisioner/pkg/metrics"
 	"github.com/onosproject/device-provisioner/pkg/southbound"
 	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
 	"github.com/onosproject/device-provisioner/pkg/store/topo"
+	"github.com/onosproject/device-provisioner/pkg/tracing"
 	provisionerapi "github.com/onosproject/onos-api/go/onos/provisioner"
 	topoapi "github.com/onosproject/onos-api/go/onos/topo"
 	"github.com/onosproject/onos-lib-go/pkg/controller/v2"
@@ -27,14 +30,20 @@ const (
 	defaultTimeout = 30 * time.Second
 	queryPeriod    = 2 * time.Minute
 	queueSize      = 100
+	// driftCheckPeriod is the minimum time between reading back the chassis config of an APPLIED device
+	driftCheckPeriod = 10 * time.Minute
 )
 
 // NewManager returns a new chassis controller manager
-func NewManager(topo topo.Store, configStore configstore.ConfigStore, realmOptions *realm.Options) *Manager {
+func NewManager(topo topo.Store, configStore configstore.ConfigStore, policies configstore.PolicyStore, audit configstore.AuditStore, realmOptions *realm.Options) *Manager {
 	manager := &Manager{
-		topo:         topo,
-		configStore:  configStore,
-		realmOptions: realmOptions,
+		topo:             topo,
+		configStore:      configStore,
+		policyCache:      utils.NewPolicyCache(policies),
+		audit:            audit,
+		realmOptions:     realmOptions,
+		getChassisConfig: southbound.GetChassisConfig,
+		driftChecks:      make(map[topoapi.ID]time.Time),
 	}
 	return manager
 
@@ -44,9 +53,18 @@ func NewManager(topo topo.Store, configStore configstore.ConfigStore, realmOptio
 type Manager struct {
 	topo         topo.Store
 	configStore  configstore.ConfigStore
+	policyCache  *utils.PolicyCache
+	audit        configstore.AuditStore
 	realmOptions *realm.Options
+	controller   *controller.Controller[topoapi.ID]
+	retries      utils.RetryTimers[topoapi.ID]
 	cancel       context.CancelFunc
 	mu           sync.Mutex
+	reconciles   utils.Reconciles
+	// getChassisConfig reads back the chassis config reported by the device
+	getChassisConfig func(ctx context.Context, target *topoapi.Object) ([]byte, error)
+	driftMu          sync.Mutex
+	driftChecks      map[topoapi.ID]time.Time
 }
 
 // Start starts manager
@@ -56,7 +74,9 @@ func (m *Manager) Start() error {
 	if m.cancel != nil {
 		return nil
 	}
-	chassisController := controller.NewController(m.reconcile)
+	m.reconciles.Resume()
+	chassisController := controller.NewController(metrics.Reconciler(metrics.ChassisController, tracing.Reconciler(metrics.ChassisController, m.reconcile)))
+	m.controller = chassisController
 
 	eventCh := make(chan topoapi.Event, queueSize)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -79,23 +99,37 @@ func (m *Manager) Start() error {
 			}
 		}
 	}()
+	go utils.SweepRealm(ctx, m.topo, m.realmOptions, queryPeriod, chassisController.Reconcile)
+	go utils.WatchPolicies(ctx, m.topo, m.policyCache, m.realmOptions, chassisController.Reconcile)
 
 	return nil
 
 }
 
-// Stop stops the manager
-func (m *Manager) Stop() {
+// Ready returns an error unless the manager is watching the assignment policies; the topo watches are reported
+// by the topo store
+func (m *Manager) Ready(ctx context.Context) error {
+	return m.policyCache.Ready(ctx)
+}
+
+// Stop stops the manager, waiting for the in-flight reconciles to complete until the context is done
+func (m *Manager) Stop(ctx context.Context) error {
 	m.mu.Lock()
 	if m.cancel != nil {
 		m.cancel()
 		m.cancel = nil
 	}
 	m.mu.Unlock()
+	m.retries.Stop()
+	return m.reconciles.Drain(ctx)
 }
 
 // Reconcile reconciles device chassis configuration
 func (m *Manager) reconcile(ctx context.Context, request controller.Request[topoapi.ID]) controller.Directive[topoapi.ID] {
+	if !m.reconciles.Begin() {
+		return request.Ack()
+	}
+	defer m.reconciles.End()
 	targetID := request.ID
 	log.Infow("Reconciling chassis config", "targetID", targetID)
 
@@ -109,6 +143,12 @@ func (m *Manager) reconcile(ctx context.Context, request controller.Request[topo
 	}
 
 	err = m.reconcileChassisConfiguration(ctx, target)
+	if retryAt, ok := utils.RetryScheduled(err); ok {
+		if m.retries.Schedule(targetID, retryAt, m.controller.Reconcile) {
+			log.Infow("Chassis config re-attempt scheduled", "targetID", targetID, "at", retryAt)
+		}
+		return request.Ack()
+	}
 	if err != nil {
 		log.Warnw("Failed reconciling chassis config", "targetID", targetID, "error", err)
 		return request.Retry(err)
@@ -117,22 +157,35 @@ func (m *Manager) reconcile(ctx context.Context, request controller.Request[topo
 }
 
 func (m *Manager) reconcileChassisConfiguration(ctx context.Context, target *topoapi.Object) error {
-	deviceConfigAspect := &provisionerapi.DeviceConfig{}
-	err := target.GetAspect(deviceConfigAspect)
-	if err != nil {
-		log.Warnw("Failed retrieving device config aspect", "targetID", target.ID, "error", err)
+	// updating the device to follow the policies again triggers another reconcile
+	if resumed, err := utils.ResumeFollowingPolicies(ctx, m.topo, m.policyCache, target); err != nil || resumed {
 		return err
 	}
+	deviceConfigAspect, err := utils.GetDeviceConfig(ctx, m.policyCache, target)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.Warnw("Failed retrieving device config", "targetID", target.ID, "error", err)
+			return err
+		}
+		log.Infow("No device config is assigned", "targetID", target.ID)
+		return nil
+	}
 	if deviceConfigAspect.ChassisConfigID == "" {
 		log.Warnw("Chassis config ID is not set", "targetID", target.ID)
 		return nil
 	}
 
+	// resolve the desired config to a specific revision, so that revision changes are treated as config changes
+	chassisConfigID, err := utils.ResolveConfigID(ctx, m.configStore, deviceConfigAspect.ChassisConfigID)
+	if err != nil {
+		return err
+	}
+
 	ccState := &provisionerapi.ChassisConfigState{}
 	err = target.GetAspect(ccState)
 	if err != nil {
 		// Create ChassisConfigState aspect
-		ccState.ConfigID = deviceConfigAspect.ChassisConfigID
+		ccState.ConfigID = chassisConfigID
 		ccState.Updated = time.Now()
 		ccState.Status.State = provisionerapi.ConfigStatus_PENDING
 		err = utils.UpdateObjectAspect(ctx, m.topo, target, "chassis", ccState)
@@ -141,8 +194,14 @@ func (m *Manager) reconcileChassisConfiguration(ctx context.Context, target *top
 		}
 		return nil
 	}
-	if ccState.ConfigID != deviceConfigAspect.ChassisConfigID {
-		ccState.ConfigID = deviceConfigAspect.ChassisConfigID
+	if utils.IsBareRef(ccState.ConfigID, chassisConfigID) {
+		log.Infow("Recording revision of chassis config state", "targetID", target.ID, "chassisConfigID", chassisConfigID)
+		ccState.ConfigID = chassisConfigID
+		return utils.UpdateObjectAspect(ctx, m.topo, target, "chassis", ccState)
+	}
+
+	if ccState.ConfigID != chassisConfigID {
+		ccState.ConfigID = chassisConfigID
 		ccState.Updated = time.Now()
 		ccState.Status.State = provisionerapi.ConfigStatus_PENDING
 		err = utils.UpdateObjectAspect(ctx, m.topo, target, "chassis", ccState)
@@ -152,25 +211,71 @@ func (m *Manager) reconcileChassisConfiguration(ctx context.Context, target *top
 		return nil
 	}
 
+	// check that the device still runs the applied config, as the pipeline controller does with the cookie
+	attempts := utils.GetConfigAttempts(target, utils.ChassisAttemptsAspect, chassisConfigID)
+	if ccState.Status.State == provisionerapi.ConfigStatus_APPLIED {
+		return m.checkChassisConfigDrift(ctx, target, ccState, attempts)
+	}
+
+	// re-attempt failed config on schedule, until the retry limit is reached
+	if ccState.Status.State == provisionerapi.ConfigStatus_FAILED {
+		if attempts.GaveUp {
+			log.Infow("Gave up applying chassis config", "targetID", target.ID, "chassisConfigID", chassisConfigID, "attempts", attempts.Attempts)
+			return nil
+		}
+		if time.Now().Before(attempts.NextAttempt) {
+			return &utils.RetryScheduledError{At: attempts.NextAttempt}
+		}
+		ccState.Updated = time.Now()
+		ccState.Status.State = provisionerapi.ConfigStatus_PENDING
+		return utils.UpdateObjectAspect(ctx, m.topo, target, "chassis", ccState)
+	}
+
 	if ccState.Status.State != provisionerapi.ConfigStatus_PENDING {
 		log.Debugw("Chassis config state is not in Pending state", "ConfigState", ccState.Status.State)
 		return nil
 	}
 
 	// get chassis configuration artifact
-	artifacts, err := utils.GetArtifacts(ctx, m.configStore, deviceConfigAspect.ChassisConfigID, 1)
+	artifacts, err := utils.GetArtifacts(ctx, m.configStore, chassisConfigID, 1)
 	if err != nil {
-		return err
+		if !configstore.IsDataLoss(err) {
+			return err
+		}
+		// corrupted artifacts will not heal by retrying; refuse to apply them
+		log.Warnw("Refusing to apply corrupted chassis config", "targetID", target.ID, "chassisConfigID", chassisConfigID, "error", err)
+		ccState.Updated = time.Now()
+		ccState.Status.State = provisionerapi.ConfigStatus_FAILED
+		attempts.GiveUp(err)
+		m.recordEvent(ctx, configstore.PushFailed, target.ID, chassisConfigID, err.Error())
+		return utils.UpdateObjectState(ctx, m.topo, target, "chassis", ccState, utils.ChassisAttemptsAspect, attempts)
 	}
 
-	// apply the chassis configuration to the device using gNMI
-	err = southbound.SetChassisConfig(target, artifacts[provisionerapi.ChassisType])
+	// render the chassis configuration for the device if it is templated...
+	config, err := chassisConfig(artifacts, target)
 	if err != nil {
-		log.Warnw("Failed to apply Stratum gNMI chassis config", target.ID, err)
-		ccState.ConfigID = deviceConfigAspect.ChassisConfigID
+		// the rendering may succeed once the device topo entity changes
+		log.Warnw("Failed to render chassis config", "targetID", target.ID, "chassisConfigID", chassisConfigID, "error", err)
 		ccState.Updated = time.Now()
 		ccState.Status.State = provisionerapi.ConfigStatus_FAILED
-		err = utils.UpdateObjectAspect(ctx, m.topo, target, "chassis", ccState)
+		attempts.Failed(utils.DefaultRetryPolicy, err)
+		m.recordEvent(ctx, configstore.PushFailed, target.ID, chassisConfigID, err.Error())
+		return utils.UpdateObjectState(ctx, m.topo, target, "chassis", ccState, utils.ChassisAttemptsAspect, attempts)
+	}
+
+	// ... and apply it to the device using gNMI
+	m.recordEvent(ctx, configstore.PushStarted, target.ID, chassisConfigID, "")
+	err = southbound.SetChassisConfig(ctx, target, config)
+	if err != nil {
+		log.Warnw("Failed to apply Stratum gNMI chassis config", "targetID", target.ID, "error", err)
+		m.recordEvent(ctx, configstore.PushFailed, target.ID, chassisConfigID, err.Error())
+		ccState.ConfigID = chassisConfigID
+		ccState.Updated = time.Now()
+		ccState.Status.State = provisionerapi.ConfigStatus_FAILED
+		if !attempts.Failed(utils.DefaultRetryPolicy, err) {
+			log.Warnw("Giving up applying chassis config", "targetID", target.ID, "chassisConfigID", chassisConfigID, "attempts", attempts.Attempts)
+		}
+		err = utils.UpdateObjectState(ctx, m.topo, target, "chassis", ccState, utils.ChassisAttemptsAspect, attempts)
 		if err != nil {
 			return err
 		}
@@ -178,13 +283,114 @@ func (m *Manager) reconcileChassisConfiguration(ctx context.Context, target *top
 	}
 
 	// Update ChassisConfigState aspect
-	ccState.ConfigID = deviceConfigAspect.ChassisConfigID
+	ccState.ConfigID = chassisConfigID
 	ccState.Updated = time.Now()
 	ccState.Status.State = provisionerapi.ConfigStatus_APPLIED
-	err = utils.UpdateObjectAspect(ctx, m.topo, target, "chassis", ccState)
+	attempts.Succeeded()
+	attempts.AppliedDigest = configstore.Digest(config)
+	m.driftChecked(target.ID)
+	m.recordEvent(ctx, configstore.PushSucceeded, target.ID, chassisConfigID, "")
+	err = utils.UpdateObjectState(ctx, m.topo, target, "chassis", ccState, utils.ChassisAttemptsAspect, attempts)
 	if err != nil {
 		return err
 	}
 	log.Infow("Chassis config is set successfully", "targetID", target.ID)
 	return nil
 }
+
+// Checks that the device still runs the applied chassis config, at most once per drift check period, and moves
+// the state back to PENDING if it does not. Templated configs are rendered again, so that changes of the device
+// topo entity are applied as well. The config reported by the device is compared with the stored config in their
+// normalized text format; a device which cannot be reached is checked again in the next period rather than
+// re-attempted, as it may still run the applied config.
+func (m *Manager) checkChassisConfigDrift(ctx context.Context, target *topoapi.Object, ccState *provisionerapi.ChassisConfigState, attempts *utils.ConfigAttempts) error {
+	if !m.driftCheckDue(target.ID) {
+		return nil
+	}
+	m.driftChecked(target.ID)
+	artifacts, err := utils.GetArtifacts(ctx, m.configStore, ccState.ConfigID, 1)
+	if err != nil || artifacts == nil {
+		return err
+	}
+	config, err := chassisConfig(artifacts, target)
+	if err != nil {
+		log.Warnw("Failed to render chassis config", "targetID", target.ID, "chassisConfigID", ccState.ConfigID, "error", err)
+		return nil
+	}
+	expectedDigest := configstore.Digest(config)
+	if attempts.AppliedDigest != "" && attempts.AppliedDigest != expectedDigest {
+		log.Infow("Rendered chassis config changed", "targetID", target.ID, "chassisConfigID", ccState.ConfigID,
+			"appliedDigest", attempts.AppliedDigest, "expectedDigest", expectedDigest)
+		m.recordEvent(ctx, configstore.DriftDetected, target.ID, ccState.ConfigID,
+			fmt.Sprintf("rendered chassis config digest %s differs from the applied digest %s", expectedDigest, attempts.AppliedDigest))
+		return m.requeue(ctx, target, ccState)
+	}
+
+	expected, err := configstore.NormalizeTextMessage(config)
+	if err != nil {
+		log.Warnw("Unable to normalize chassis config", "targetID", target.ID, "chassisConfigID", ccState.ConfigID, "error", err)
+		return nil
+	}
+	reported, err := m.getChassisConfig(ctx, target)
+	if err != nil && !errors.IsNotFound(err) {
+		log.Warnw("Unable to check Stratum gNMI chassis config", "targetID", target.ID, "error", err)
+		return nil
+	}
+	// a device reporting no chassis config has lost the applied one
+	normalized, err := configstore.NormalizeTextMessage(reported)
+	if err != nil {
+		log.Warnw("Unable to normalize reported chassis config", "targetID", target.ID, "error", err)
+		return nil
+	}
+	if string(normalized) == string(expected) {
+		log.Debugw("Device chassis config is up to date", "targetID", target.ID)
+		return nil
+	}
+
+	reportedDigest := configstore.Digest(normalized)
+	log.Warnw("Device chassis config drifted from the applied config", "targetID", target.ID,
+		"chassisConfigID", ccState.ConfigID, "reportedDigest", reportedDigest, "expectedDigest", configstore.Digest(expected))
+	m.recordEvent(ctx, configstore.DriftDetected, target.ID, ccState.ConfigID,
+		fmt.Sprintf("device reports chassis config digest %s instead of %s", reportedDigest, configstore.Digest(expected)))
+	return m.requeue(ctx, target, ccState)
+}
+
+// Moves the chassis config state of the device back to PENDING, so that the config is applied again
+func (m *Manager) requeue(ctx context.Context, target *topoapi.Object, ccState *provisionerapi.ChassisConfigState) error {
+	ccState.Updated = time.Now()
+	ccState.Status.State = provisionerapi.ConfigStatus_PENDING
+	return utils.UpdateObjectAspect(ctx, m.topo, target, "chassis", ccState)
+}
+
+// Returns true if the drift check period has passed since the chassis config of the device was last checked
+func (m *Manager) driftCheckDue(targetID topoapi.ID) bool {
+	m.driftMu.Lock()
+	defer m.driftMu.Unlock()
+	return time.Since(m.driftChecks[targetID]) >= driftCheckPeriod
+}
+
+// Records that the chassis config of the device was just checked or applied
+func (m *Manager) driftChecked(targetID topoapi.ID) {
+	m.driftMu.Lock()
+	defer m.driftMu.Unlock()
+	m.driftChecks[targetID] = time.Now()
+}
+
+// Records the audit event concerning the chassis config of the device
+func (m *Manager) recordEvent(ctx context.Context, eventType configstore.AuditEventType, targetID topoapi.ID, configID provisionerapi.ConfigID, message string) {
+	utils.RecordEvent(ctx, m.audit, &configstore.AuditEvent{
+		Type:     eventType,
+		TargetID: targetID,
+		ConfigID: configID,
+		Kind:     configstore.ChassisConfigKind,
+		Message:  message,
+	})
+}
+
+// Returns the chassis config to apply to the device, rendering it from the template artifact if there is one
+func chassisConfig(artifacts configstore.Artifacts, target *topoapi.Object) ([]byte, error) {
+	if text, ok := artifacts[configstore.ChassisTemplateType]; ok {
+		return configstore.RenderChassisConfig(text, target)
+	}
+	return artifacts[provisionerapi.ChassisType], nil
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chassis
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/atomix/go-sdk/pkg/test"
+	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
+	"github.com/onosproject/device-provisioner/pkg/store/topo/topotest"
+	provisionerapi "github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-net-lib/pkg/realm"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testChassisConfig   = "description: \"leaf switch\"\nnodes {\n  id: 1\n}\n"
+	testChassisTemplate = "description: \"{{ .Labels.role }} switch\"\n"
+)
+
+var testRealm = &realm.Options{Label: "pod", Value: "pod-1"}
+
+// testReport is what the device under test reports as its chassis config
+type testReport struct {
+	config []byte
+	err    error
+	reads  int
+}
+
+func (r *testReport) get(ctx context.Context, target *topoapi.Object) ([]byte, error) {
+	r.reads++
+	return r.config, r.err
+}
+
+func newTestManager(t *testing.T, artifacts configstore.Artifacts, object *topoapi.Object) (*Manager, *topotest.Store, *testReport) {
+	cluster := test.NewClient()
+	t.Cleanup(cluster.Close)
+	backend, err := configstore.NewInlineBackend(cluster, 1024)
+	assert.NoError(t, err)
+	configStore, err := configstore.NewAtomixStore(cluster, backend, configstore.DefaultRevisionRetention)
+	assert.NoError(t, err)
+	assert.NoError(t, configStore.Add(context.TODO(), &provisionerapi.ConfigRecord{ConfigID: "ch_leaf", Kind: configstore.ChassisConfigKind}, artifacts))
+	policies, err := configstore.NewAtomixPolicyStore(cluster)
+	assert.NoError(t, err)
+	audit, err := configstore.NewAtomixAuditStore(cluster, configstore.DefaultAuditCapacity)
+	assert.NoError(t, err)
+	topo := topotest.NewStore(object)
+	report := &testReport{}
+	m := NewManager(topo, configStore, policies, audit, testRealm)
+	m.getChassisConfig = report.get
+	return m, topo, report
+}
+
+// Returns a device of the realm which applied the chassis config with the given digest
+func newDevice(t *testing.T, appliedDigest string) *topoapi.Object {
+	object := &topoapi.Object{ID: "leaf1", Type: topoapi.Object_ENTITY, Obj: &topoapi.Object_Entity{Entity: &topoapi.Entity{}},
+		Labels: map[string]string{testRealm.Label: testRealm.Value, "role": "leaf"}}
+	assert.NoError(t, object.SetAspect(&topoapi.StratumAgents{}))
+	assert.NoError(t, object.SetAspect(&provisionerapi.DeviceConfig{ChassisConfigID: "ch_leaf"}))
+	assert.NoError(t, object.SetAspect(&provisionerapi.ChassisConfigState{ConfigID: "ch_leaf@1",
+		Status: provisionerapi.ConfigStatus{State: provisionerapi.ConfigStatus_APPLIED}}))
+	data, err := json.Marshal(&utils.ConfigAttempts{ConfigID: "ch_leaf@1", AppliedConfigID: "ch_leaf@1", AppliedDigest: appliedDigest})
+	assert.NoError(t, err)
+	assert.NoError(t, object.SetAspectBytes(utils.ChassisAttemptsAspect, data))
+	return object
+}
+
+// Reconciles the device and returns its chassis config state and the drift events recorded for it
+func reconcile(t *testing.T, m *Manager, topo *topotest.Store) (provisionerapi.ConfigStatus_State, []*configstore.AuditEvent) {
+	ctx := context.TODO()
+	object, err := topo.Get(ctx, "leaf1")
+	assert.NoError(t, err)
+	assert.NoError(t, m.reconcileChassisConfiguration(ctx, object))
+	object, err = topo.Get(ctx, "leaf1")
+	assert.NoError(t, err)
+	ccState := &provisionerapi.ChassisConfigState{}
+	assert.NoError(t, object.GetAspect(ccState))
+	events, err := m.audit.List(ctx, configstore.AuditFilter{TargetID: "leaf1"})
+	assert.NoError(t, err)
+	drifts := make([]*configstore.AuditEvent, 0)
+	for _, event := range events {
+		if event.Type == configstore.DriftDetected {
+			drifts = append(drifts, event)
+		}
+	}
+	return ccState.Status.State, drifts
+}
+
+func TestChassisConfigNoDrift(t *testing.T) {
+	m, topo, report := newTestManager(t, configstore.Artifacts{provisionerapi.ChassisType: []byte(testChassisConfig)},
+		newDevice(t, configstore.Digest([]byte(testChassisConfig))))
+
+	// The device reports the stored config in another formatting
+	report.config = []byte("# reported\ndescription: 'leaf switch' nodes < id: 1 >")
+	state, drifts := reconcile(t, m, topo)
+	assert.Equal(t, provisionerapi.ConfigStatus_APPLIED, state)
+	assert.Len(t, drifts, 0)
+	assert.Equal(t, 1, report.reads)
+
+	// The device is not read back again until the drift check period passes
+	report.config = []byte("description: \"spine switch\"")
+	state, _ = reconcile(t, m, topo)
+	assert.Equal(t, provisionerapi.ConfigStatus_APPLIED, state)
+	assert.Equal(t, 1, report.reads)
+}
+
+func TestChassisConfigDrift(t *testing.T) {
+	m, topo, report := newTestManager(t, configstore.Artifacts{provisionerapi.ChassisType: []byte(testChassisConfig)},
+		newDevice(t, configstore.Digest([]byte(testChassisConfig))))
+
+	report.config = []byte("description: \"spine switch\"\nnodes {\n  id: 1\n}\n")
+	state, drifts := reconcile(t, m, topo)
+	assert.Equal(t, provisionerapi.ConfigStatus_PENDING, state)
+	assert.Len(t, drifts, 1)
+	assert.Equal(t, provisionerapi.ConfigID("ch_leaf@1"), drifts[0].ConfigID)
+}
+
+func TestChassisConfigLost(t *testing.T) {
+	m, topo, report := newTestManager(t, configstore.Artifacts{provisionerapi.ChassisType: []byte(testChassisConfig)},
+		newDevice(t, configstore.Digest([]byte(testChassisConfig))))
+
+	// A device reporting no chassis config, e.g. after a reboot, has drifted as well
+	report.err = errors.NewNotFound("device leaf1 reported no chassis config")
+	state, drifts := reconcile(t, m, topo)
+	assert.Equal(t, provisionerapi.ConfigStatus_PENDING, state)
+	assert.Len(t, drifts, 1)
+}
+
+func TestChassisConfigUnreachable(t *testing.T) {
+	m, topo, report := newTestManager(t, configstore.Artifacts{provisionerapi.ChassisType: []byte(testChassisConfig)},
+		newDevice(t, configstore.Digest([]byte(testChassisConfig))))
+
+	// An unreachable device keeps its state and is not re-attempted
+	report.err = errors.NewUnavailable("connection refused")
+	state, drifts := reconcile(t, m, topo)
+	assert.Equal(t, provisionerapi.ConfigStatus_APPLIED, state)
+	assert.Len(t, drifts, 0)
+	assert.Equal(t, 1, report.reads)
+}
+
+func TestChassisConfigRerendered(t *testing.T) {
+	m, topo, report := newTestManager(t, configstore.Artifacts{configstore.ChassisTemplateType: []byte(testChassisTemplate)},
+		newDevice(t, configstore.Digest([]byte("description: \"spine switch\"\n"))))
+
+	// The template renders differently for the device than when it was applied
+	state, drifts := reconcile(t, m, topo)
+	assert.Equal(t, provisionerapi.ConfigStatus_PENDING, state)
+	assert.Len(t, drifts, 1)
+	assert.Equal(t, 0, report.reads)
+}
@@ -0,0 +1,355 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
+	provisionerapi "github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	p4info "github.com/p4lang/p4runtime/go/p4/config/v1"
+	p4api "github.com/p4lang/p4runtime/go/p4/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+const (
+	// batchStepTimeout bounds each step of a batch, i.e. the verification or commit on a single device
+	batchStepTimeout = 2 * time.Minute
+	// batchRetryInterval is the interval after which a device awaiting a configuration applied by a batch
+	// it is not a member of is reconciled again, so that it joins the next batch
+	batchRetryInterval = 30 * time.Second
+)
+
+// Joins the device to the batch applying the pipeline config to all devices in the realm which reference it,
+// creating the batch if there is none, and runs the batch unless it is already running. The batch has its
+// fixed set of devices and outlives the reconcile; devices awaiting the config which are not members of
+// the running batch are reconciled again once it is done.
+func (m *Manager) joinBatch(ctx context.Context, target *topoapi.Object, configID provisionerapi.ConfigID) error {
+	id := configstore.BatchID(m.realmOptions.Value, configID)
+	batch, err := m.batches.Get(ctx, id)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if batch == nil {
+		devices, err := m.batchDevices(ctx, configID)
+		if err != nil {
+			return err
+		}
+		batch = &configstore.Batch{
+			ID:       id,
+			ConfigID: configID,
+			Devices:  devices,
+			Phase:    configstore.BatchVerifying,
+			Cookie:   uint64(time.Now().UnixNano()),
+		}
+		if err = m.batches.Create(ctx, batch); err != nil {
+			log.Warnw("Unable to create pipeline config batch", "pipelineConfigID", configID, "error", err)
+			return err
+		}
+		log.Infow("Created pipeline config batch", "pipelineConfigID", configID, "devices", batch.Devices)
+	}
+	m.runBatch(batch)
+	if !utils.Contains(batch.Devices, target.ID) {
+		return &utils.RetryScheduledError{At: time.Now().Add(batchRetryInterval)}
+	}
+	return nil
+}
+
+// Resumes the batches of the realm left unfinished, e.g. by a restart of the provisioner
+func (m *Manager) resumeBatches(ctx context.Context) {
+	batches, err := m.batches.List(ctx)
+	if err != nil {
+		log.Warnw("Unable to list pipeline config batches", "error", err)
+		return
+	}
+	for _, batch := range batches {
+		if batch.ID == configstore.BatchID(m.realmOptions.Value, batch.ConfigID) {
+			log.Infow("Resuming pipeline config batch", "pipelineConfigID", batch.ConfigID, "phase", batch.Phase)
+			m.runBatch(batch)
+		}
+	}
+}
+
+// Runs the batch in the background unless it is already running
+func (m *Manager) runBatch(batch *configstore.Batch) {
+	m.batchMu.Lock()
+	defer m.batchMu.Unlock()
+	if m.runningBatches[batch.ID] {
+		return
+	}
+	m.runningBatches[batch.ID] = true
+	go func() {
+		defer func() {
+			m.batchMu.Lock()
+			delete(m.runningBatches, batch.ID)
+			m.batchMu.Unlock()
+		}()
+		m.applyBatch(batch)
+	}()
+}
+
+// Applies the pipeline config to the devices of the batch in two phases; the config is first verified and saved
+// on every device and only then committed, so that a config rejected by any device is committed nowhere. The
+// progress is recorded after each device, so that the batch can resume; each step on a device is tracked as
+// a reconcile, so that stopping the controller waits for the step in progress and leaves the rest to be resumed.
+func (m *Manager) applyBatch(batch *configstore.Batch) {
+	ctx := context.Background()
+	artifacts, err := utils.GetArtifacts(ctx, m.configStore, batch.ConfigID, 2)
+	if err != nil {
+		log.Warnw("Failed to retrieve artifacts", "pipelineConfigID", batch.ConfigID, "error", err)
+		if configstore.IsDataLoss(err) {
+			m.failBatch(ctx, batch, "", err)
+		}
+		return
+	}
+	if artifacts == nil {
+		// the configuration was deleted while the batch was in progress
+		log.Warnw("Pipeline config not found", "pipelineConfigID", batch.ConfigID)
+		m.failBatch(ctx, batch, "", errors.NewNotFound("pipeline config %s not found", batch.ConfigID))
+		return
+	}
+	p4i := &p4info.P4Info{}
+	if err = prototext.Unmarshal(artifacts[provisionerapi.P4InfoType], p4i); err != nil {
+		log.Warnw("Failed to unmarshal p4info", "error", err)
+		m.failBatch(ctx, batch, "", err)
+		return
+	}
+	binary := artifacts[provisionerapi.P4BinaryType]
+
+	if batch.Phase == configstore.BatchVerifying {
+		log.Infow("Verifying pipeline config across batch", "pipelineConfigID", batch.ConfigID, "devices", len(batch.Devices))
+		for _, targetID := range batch.Devices {
+			if utils.Contains(batch.Verified, targetID) {
+				continue
+			}
+			if !m.reconciles.Begin() {
+				return
+			}
+			verified, err := m.verifyMember(targetID, batch, p4i, binary)
+			m.reconciles.End()
+			if err != nil {
+				log.Warnw("Failed to verify pipeline config", "targetID", targetID, "pipelineConfigID", batch.ConfigID, "error", err)
+				m.failBatch(ctx, batch, targetID, err)
+				return
+			}
+			if !verified {
+				continue
+			}
+			batch.Verified = append(batch.Verified, targetID)
+			if !m.updateBatch(ctx, batch) {
+				return
+			}
+		}
+		batch.Phase = configstore.BatchCommitting
+		if !m.updateBatch(ctx, batch) {
+			return
+		}
+	}
+
+	for _, targetID := range batch.Devices {
+		// the devices which no longer awaited the config when verifying it have nothing saved to commit
+		if !utils.Contains(batch.Verified, targetID) || utils.Contains(batch.Committed, targetID) {
+			continue
+		}
+		if !m.reconciles.Begin() {
+			return
+		}
+		m.commitMember(targetID, batch)
+		m.reconciles.End()
+		batch.Committed = append(batch.Committed, targetID)
+		if !m.updateBatch(ctx, batch) {
+			return
+		}
+	}
+	if err = m.batches.Delete(ctx, batch.ID); err != nil {
+		log.Warnw("Unable to delete pipeline config batch", "pipelineConfigID", batch.ConfigID, "error", err)
+	}
+	log.Infow("Applied pipeline config batch", "pipelineConfigID", batch.ConfigID, "devices", len(batch.Devices))
+}
+
+// Verifies and saves the config on the device, unless the device no longer awaits the config; returns whether
+// the device verified and saved the config
+func (m *Manager) verifyMember(targetID topoapi.ID, batch *configstore.Batch, p4i *p4info.P4Info, binary []byte) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), batchStepTimeout)
+	defer cancel()
+	target, _, err := m.batchMember(ctx, targetID, batch.ConfigID)
+	if err != nil || target == nil {
+		return false, err
+	}
+	m.recordEvent(ctx, configstore.PushStarted, targetID, batch.ConfigID, "")
+	conn, electionID, deviceID, err := m.connect(ctx, target)
+	if err != nil {
+		return false, err
+	}
+	err = setPipelineConfig(ctx, conn, targetID, &p4api.SetForwardingPipelineConfigRequest{
+		DeviceId:   deviceID,
+		Role:       provisionerRoleName,
+		ElectionId: electionID,
+		Action:     p4api.SetForwardingPipelineConfigRequest_VERIFY_AND_SAVE,
+		Config: &p4api.ForwardingPipelineConfig{
+			P4Info:         p4i,
+			P4DeviceConfig: binary,
+			Cookie:         &p4api.ForwardingPipelineConfig_Cookie{Cookie: batch.Cookie},
+		},
+	})
+	return err == nil, err
+}
+
+// Commits the saved config on the device and records the outcome in its state, unless the device no longer
+// awaits the config
+func (m *Manager) commitMember(targetID topoapi.ID, batch *configstore.Batch) {
+	ctx, cancel := context.WithTimeout(context.Background(), batchStepTimeout)
+	defer cancel()
+	target, pcState, err := m.batchMember(ctx, targetID, batch.ConfigID)
+	if err != nil || target == nil {
+		return
+	}
+	conn, electionID, deviceID, err := m.connect(ctx, target)
+	if err == nil {
+		err = setPipelineConfig(ctx, conn, targetID, &p4api.SetForwardingPipelineConfigRequest{
+			DeviceId:   deviceID,
+			Role:       provisionerRoleName,
+			ElectionId: electionID,
+			Action:     p4api.SetForwardingPipelineConfigRequest_COMMIT,
+		})
+	}
+	attempts := utils.GetConfigAttempts(target, utils.PipelineAttemptsAspect, batch.ConfigID)
+	if err != nil {
+		log.Warnw("Failed to commit pipeline config", "targetID", targetID, "pipelineConfigID", batch.ConfigID, "error", err)
+		_ = m.memberFailed(ctx, target, pcState, attempts, err)
+		return
+	}
+	pcState.ConfigID = batch.ConfigID
+	pcState.Updated = time.Now()
+	pcState.Status.State = provisionerapi.ConfigStatus_APPLIED
+	pcState.Cookie = batch.Cookie
+	attempts.Succeeded()
+	m.recordEvent(ctx, configstore.PushSucceeded, targetID, batch.ConfigID, "")
+	if err = utils.UpdateObjectState(ctx, m.topo, target, pipelineKind, pcState, utils.PipelineAttemptsAspect, attempts); err != nil {
+		return
+	}
+	log.Infow("Device pipeline config is set successfully", "targetID", targetID, "Status", pcState.Status.State)
+}
+
+// Returns the devices in the realm which reference the specified pipeline config revision and have neither
+// applied it already nor given up applying it
+func (m *Manager) batchDevices(ctx context.Context, configID provisionerapi.ConfigID) ([]topoapi.ID, error) {
+	baseID, _, err := configstore.ParseRef(configID)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := utils.QueryConfigObjects(ctx, m.topo, m.policyCache, m.realmOptions, baseID)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := m.policyCache.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	devices := make([]topoapi.ID, 0, len(objects))
+	for _, object := range objects {
+		deviceConfig, _ := utils.AssignedDeviceConfig(object, policies)
+		pipelineConfigID, err := utils.ResolveConfigID(ctx, m.configStore, deviceConfig.PipelineConfigID)
+		if err != nil {
+			return nil, err
+		}
+		if pipelineConfigID != configID {
+			continue
+		}
+		pcState := &provisionerapi.PipelineConfigState{}
+		if err := object.GetAspect(pcState); err == nil && pcState.ConfigID == configID {
+			if pcState.Status.State == provisionerapi.ConfigStatus_APPLIED {
+				continue
+			}
+			if utils.GetConfigAttempts(object, utils.PipelineAttemptsAspect, configID).GaveUp {
+				continue
+			}
+		}
+		devices = append(devices, object.ID)
+	}
+	return devices, nil
+}
+
+// Returns the device of the batch and its pipeline config state, or nil if the device no longer exists or no
+// longer references the config of the batch
+func (m *Manager) batchMember(ctx context.Context, targetID topoapi.ID, configID provisionerapi.ConfigID) (*topoapi.Object, *provisionerapi.PipelineConfigState, error) {
+	target, err := m.topo.Get(ctx, targetID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	deviceConfig, err := utils.GetDeviceConfig(ctx, m.policyCache, target)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	pipelineConfigID, err := utils.ResolveConfigID(ctx, m.configStore, deviceConfig.PipelineConfigID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pipelineConfigID != configID {
+		log.Infow("Leaving device which no longer references the config out of pipeline config batch", "targetID", targetID, "pipelineConfigID", configID)
+		return nil, nil, nil
+	}
+	pcState := &provisionerapi.PipelineConfigState{}
+	_ = target.GetAspect(pcState)
+	return target, pcState, nil
+}
+
+// Records the progress of the batch; returns false if it could not be recorded, in which case the batch is
+// left to be resumed
+func (m *Manager) updateBatch(ctx context.Context, batch *configstore.Batch) bool {
+	if err := m.batches.Update(ctx, batch); err != nil {
+		log.Warnw("Unable to record pipeline config batch progress", "pipelineConfigID", batch.ConfigID, "error", err)
+		return false
+	}
+	return true
+}
+
+// Marks all devices in the batch as failed after the config failed verification on one of them, given by its ID,
+// or could not be applied at all, and deletes the batch, so that the failed devices form a new batch when they
+// are re-attempted
+func (m *Manager) failBatch(ctx context.Context, batch *configstore.Batch, failedID topoapi.ID, cause error) {
+	for _, targetID := range batch.Devices {
+		stepCtx, cancel := context.WithTimeout(ctx, batchStepTimeout)
+		target, pcState, err := m.batchMember(stepCtx, targetID, batch.ConfigID)
+		if err == nil && target != nil {
+			err = cause
+			if failedID != "" && targetID != failedID {
+				err = status.Errorf(codes.Aborted, "pipeline config verification failed on %s: %v", failedID, cause)
+			}
+			attempts := utils.GetConfigAttempts(target, utils.PipelineAttemptsAspect, batch.ConfigID)
+			_ = m.memberFailed(stepCtx, target, pcState, attempts, err)
+		}
+		cancel()
+	}
+	if err := m.batches.Delete(ctx, batch.ID); err != nil {
+		log.Warnw("Unable to delete pipeline config batch", "pipelineConfigID", batch.ConfigID, "error", err)
+	}
+}
+
+// Records the failed attempt to apply the pipeline config to the device
+func (m *Manager) memberFailed(ctx context.Context, target *topoapi.Object, pcState *provisionerapi.PipelineConfigState, attempts *utils.ConfigAttempts, err error) error {
+	pcState.ConfigID = attempts.ConfigID
+	pcState.Updated = time.Now()
+	pcState.Status.State = provisionerapi.ConfigStatus_FAILED
+	m.recordEvent(ctx, configstore.PushFailed, target.ID, pcState.ConfigID, err.Error())
+	if configstore.IsDataLoss(err) {
+		attempts.GiveUp(err)
+	} else if !attempts.Failed(utils.DefaultRetryPolicy, err) {
+		log.Warnw("Giving up applying device pipeline config", "targetID", target.ID, "pipelineConfigID", pcState.ConfigID, "attempts", attempts.Attempts)
+	}
+	return utils.UpdateObjectState(ctx, m.topo, target, pipelineKind, pcState, utils.PipelineAttemptsAspect, attempts)
+}
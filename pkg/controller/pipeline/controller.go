@@ -7,9 +7,12 @@ package pipeline
 
 import (
 	"context"
+	"fmt"
 	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	"github.com/onosproject/device-provisioner/pkg/metrics"
 	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
 	"github.com/onosproject/device-provisioner/pkg/store/topo"
+	"github.com/onosproject/device-provisioner/pkg/tracing"
 	provisionerapi "github.com/onosproject/onos-api/go/onos/provisioner"
 	topoapi "github.com/onosproject/onos-api/go/onos/topo"
 	"github.com/onosproject/onos-lib-go/pkg/controller/v2"
@@ -20,6 +23,7 @@ import (
 	"github.com/onosproject/onos-net-lib/pkg/realm"
 	p4info "github.com/p4lang/p4runtime/go/p4/config/v1"
 	p4api "github.com/p4lang/p4runtime/go/p4/v1"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/encoding/prototext"
 	"sync"
 
@@ -31,16 +35,21 @@ var log = logging.GetLogger()
 const (
 	provisionerRoleName = "provisioner"
 	pipelineKind        = "pipeline"
+	queryPeriod         = 2 * time.Minute
 	queueSize           = 100
 )
 
 // NewManager returns a new pipeline controller manager
-func NewManager(topo topo.Store, conns p4rtclient.ConnManager, configStore configstore.ConfigStore, realmOptions *realm.Options) *Manager {
+func NewManager(topo topo.Store, conns p4rtclient.ConnManager, configStore configstore.ConfigStore, policies configstore.PolicyStore, audit configstore.AuditStore, batches configstore.BatchStore, realmOptions *realm.Options) *Manager {
 	manager := &Manager{
-		conns:        conns,
-		topo:         topo,
-		configStore:  configStore,
-		realmOptions: realmOptions,
+		conns:          conns,
+		topo:           topo,
+		configStore:    configStore,
+		policyCache:    utils.NewPolicyCache(policies),
+		audit:          audit,
+		batches:        batches,
+		realmOptions:   realmOptions,
+		runningBatches: make(map[string]bool),
 	}
 	return manager
 
@@ -48,12 +57,20 @@ func NewManager(topo topo.Store, conns p4rtclient.ConnManager, configStore confi
 
 // Manager reconciles pipeline configuration
 type Manager struct {
-	conns        p4rtclient.ConnManager
-	topo         topo.Store
-	configStore  configstore.ConfigStore
-	realmOptions *realm.Options
-	cancel       context.CancelFunc
-	mu           sync.Mutex
+	conns          p4rtclient.ConnManager
+	topo           topo.Store
+	configStore    configstore.ConfigStore
+	policyCache    *utils.PolicyCache
+	audit          configstore.AuditStore
+	batches        configstore.BatchStore
+	realmOptions   *realm.Options
+	controller     *controller.Controller[topoapi.ID]
+	retries        utils.RetryTimers[topoapi.ID]
+	cancel         context.CancelFunc
+	mu             sync.Mutex
+	batchMu        sync.Mutex
+	runningBatches map[string]bool
+	reconciles     utils.Reconciles
 }
 
 // Start starts new reconciler
@@ -63,7 +80,9 @@ func (m *Manager) Start() error {
 	if m.cancel != nil {
 		return nil
 	}
-	pipelineController := controller.NewController(m.reconcile)
+	m.reconciles.Resume()
+	pipelineController := controller.NewController(metrics.Reconciler(metrics.PipelineController, tracing.Reconciler(metrics.PipelineController, m.reconcile)))
+	m.controller = pipelineController
 	eventCh := make(chan topoapi.Event, queueSize)
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -85,12 +104,37 @@ func (m *Manager) Start() error {
 			}
 		}
 	}()
+	go utils.SweepRealm(ctx, m.topo, m.realmOptions, queryPeriod, pipelineController.Reconcile)
+	go utils.WatchPolicies(ctx, m.topo, m.policyCache, m.realmOptions, pipelineController.Reconcile)
+	go m.resumeBatches(ctx)
 
 	return nil
 }
 
+// Ready returns an error unless the manager is watching the assignment policies; the topo watches are reported
+// by the topo store
+func (m *Manager) Ready(ctx context.Context) error {
+	return m.policyCache.Ready(ctx)
+}
+
+// Stop stops the manager, waiting for the in-flight reconciles to complete until the context is done
+func (m *Manager) Stop(ctx context.Context) error {
+	m.mu.Lock()
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
+	m.mu.Unlock()
+	m.retries.Stop()
+	return m.reconciles.Drain(ctx)
+}
+
 // Reconcile reconciles device pipeline config
 func (m *Manager) reconcile(ctx context.Context, request controller.Request[topoapi.ID]) controller.Directive[topoapi.ID] {
+	if !m.reconciles.Begin() {
+		return request.Ack()
+	}
+	defer m.reconciles.End()
 	targetID := request.ID
 	log.Infow("Reconciling device pipeline config", "targetID", targetID)
 
@@ -104,6 +148,12 @@ func (m *Manager) reconcile(ctx context.Context, request controller.Request[topo
 	}
 
 	err = m.reconcilePipelineConfiguration(ctx, target)
+	if retryAt, ok := utils.RetryScheduled(err); ok {
+		if m.retries.Schedule(targetID, retryAt, m.controller.Reconcile) {
+			log.Infow("Device pipeline configuration re-attempt scheduled", "targetID", targetID, "at", retryAt)
+		}
+		return request.Ack()
+	}
 	if err != nil {
 		log.Warnw("Failed reconciling device pipeline configuration", "targetID", targetID, "error", err)
 		return request.Retry(err)
@@ -114,23 +164,36 @@ func (m *Manager) reconcile(ctx context.Context, request controller.Request[topo
 
 func (m *Manager) reconcilePipelineConfiguration(ctx context.Context, target *topoapi.Object) error {
 	targetID := target.ID
-	deviceConfigAspect := &provisionerapi.DeviceConfig{}
-	err := target.GetAspect(deviceConfigAspect)
-	if err != nil {
-		log.Warnw("Failed retrieving device config aspect", "targetID", targetID, "error", err)
+	// updating the device to follow the policies again triggers another reconcile
+	if resumed, err := utils.ResumeFollowingPolicies(ctx, m.topo, m.policyCache, target); err != nil || resumed {
 		return err
 	}
+	deviceConfigAspect, err := utils.GetDeviceConfig(ctx, m.policyCache, target)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.Warnw("Failed retrieving device config", "targetID", targetID, "error", err)
+			return err
+		}
+		log.Infow("No device config is assigned", "targetID", targetID)
+		return nil
+	}
 
 	if deviceConfigAspect.PipelineConfigID == "" {
 		log.Warnw("Pipeline config ID is not set", "targetID", targetID)
 		return nil
 	}
 
+	// resolve the desired config to a specific revision, so that revision changes are treated as config changes
+	pipelineConfigID, err := utils.ResolveConfigID(ctx, m.configStore, deviceConfigAspect.PipelineConfigID)
+	if err != nil {
+		return err
+	}
+
 	pcState := &provisionerapi.PipelineConfigState{}
 	err = target.GetAspect(pcState)
 	if err != nil {
 		log.Warnw("Pipeline config state aspect not found", "targetID", targetID, "error", err)
-		pcState.ConfigID = deviceConfigAspect.PipelineConfigID
+		pcState.ConfigID = pipelineConfigID
 		pcState.Updated = time.Now()
 		pcState.Status.State = provisionerapi.ConfigStatus_PENDING
 		pcState.Cookie = 0
@@ -141,8 +204,14 @@ func (m *Manager) reconcilePipelineConfiguration(ctx context.Context, target *to
 		return nil
 	}
 
-	if pcState.ConfigID != deviceConfigAspect.PipelineConfigID {
-		pcState.ConfigID = deviceConfigAspect.PipelineConfigID
+	if utils.IsBareRef(pcState.ConfigID, pipelineConfigID) {
+		log.Infow("Recording revision of pipeline config state", "targetID", targetID, "pipelineConfigID", pipelineConfigID)
+		pcState.ConfigID = pipelineConfigID
+		return utils.UpdateObjectAspect(ctx, m.topo, target, pipelineKind, pcState)
+	}
+
+	if pcState.ConfigID != pipelineConfigID {
+		pcState.ConfigID = pipelineConfigID
 		pcState.Updated = time.Now()
 		pcState.Status.State = provisionerapi.ConfigStatus_PENDING
 		pcState.Cookie = 0
@@ -153,30 +222,28 @@ func (m *Manager) reconcilePipelineConfiguration(ctx context.Context, target *to
 		return nil
 	}
 
-	stratumAgents := &topoapi.StratumAgents{}
-	if err := target.GetAspect(stratumAgents); err != nil {
-		log.Warnw("Failed to extract stratum agents aspect", "error", err)
-		return err
-	}
-
-	p4rtConn, err := m.conns.GetByTarget(ctx, target.ID)
-	if err != nil {
-		log.Warnw("Connection not found for target", "target ID", target.ID)
-		return err
+	// re-attempt failed config on schedule, until the retry limit is reached
+	attempts := utils.GetConfigAttempts(target, utils.PipelineAttemptsAspect, pipelineConfigID)
+	if pcState.Status.State == provisionerapi.ConfigStatus_FAILED {
+		if attempts.GaveUp {
+			log.Infow("Gave up applying device pipeline config", "targetID", targetID, "pipelineConfigID", pipelineConfigID, "attempts", attempts.Attempts)
+			return nil
+		}
+		if time.Now().Before(attempts.NextAttempt) {
+			return &utils.RetryScheduledError{At: attempts.NextAttempt}
+		}
+		pcState.Updated = time.Now()
+		pcState.Status.State = provisionerapi.ConfigStatus_PENDING
+		return utils.UpdateObjectAspect(ctx, m.topo, target, pipelineKind, pcState)
 	}
 
-	role := p4utils.NewStratumRole(provisionerRoleName, 0, []byte{}, false, true)
-	arbitrationResponse, err := p4rtConn.PerformMasterArbitration(ctx, role)
+	p4rtConn, electionID, deviceID, err := m.connect(ctx, target)
 	if err != nil {
-		log.Warnw("Failed to perform master arbitration", "error", err)
 		return err
 	}
-
-	electionID := arbitrationResponse.Arbitration.ElectionId
-	log.Info(electionID)
 	// ask for the pipeline config cookie
 	gr, err := p4rtConn.GetForwardingPipelineConfig(ctx, &p4api.GetForwardingPipelineConfigRequest{
-		DeviceId:     stratumAgents.DeviceID,
+		DeviceId:     deviceID,
 		ResponseType: p4api.GetForwardingPipelineConfigRequest_COOKIE_ONLY,
 	})
 	if err != nil {
@@ -190,17 +257,45 @@ func (m *Manager) reconcilePipelineConfiguration(ctx context.Context, target *to
 		return nil
 	}
 
+	// the device lost the applied pipeline config, e.g. due to a reboot; re-apply it
+	if pcState.Status.State == provisionerapi.ConfigStatus_APPLIED {
+		log.Infow("Device pipeline config cookie does not match", "targetID", targetID, "cookie", gr.Config.Cookie.Cookie)
+		m.recordEvent(ctx, configstore.DriftDetected, targetID, pipelineConfigID,
+			fmt.Sprintf("device cookie %d does not match applied cookie %d", gr.Config.Cookie.Cookie, pcState.Cookie))
+		pcState.Updated = time.Now()
+		pcState.Status.State = provisionerapi.ConfigStatus_PENDING
+		return utils.UpdateObjectAspect(ctx, m.topo, target, pipelineKind, pcState)
+	}
+
 	if pcState.Status.State != provisionerapi.ConfigStatus_PENDING {
 		log.Infow("Device Pipeline config state is not in Pending state", "targetID", targetID, "ConfigState", pcState.Status.State)
 		return nil
 	}
 
-	// Otherwise... get the pipeline config artifacts
-	artifacts, err := utils.GetArtifacts(ctx, m.configStore, deviceConfigAspect.PipelineConfigID, 2)
+	mode, err := m.applyMode(ctx, pipelineConfigID)
 	if err != nil {
-		log.Warnw("Failed to retrieve artifacts", "targetID", targetID, "pipelineConfigID", deviceConfigAspect.PipelineConfigID, "error", err)
 		return err
 	}
+	if mode == configstore.VerifyThenCommitMode {
+		// verify the config across all devices awaiting it before committing it anywhere
+		return m.joinBatch(ctx, target, pipelineConfigID)
+	}
+
+	// Otherwise... get the pipeline config artifacts
+	artifacts, err := utils.GetArtifacts(ctx, m.configStore, pipelineConfigID, 2)
+	if err != nil {
+		log.Warnw("Failed to retrieve artifacts", "targetID", targetID, "pipelineConfigID", pipelineConfigID, "error", err)
+		if !configstore.IsDataLoss(err) {
+			return err
+		}
+		// corrupted artifacts will not heal by retrying; refuse to apply them
+		log.Warnw("Refusing to apply corrupted pipeline config", "targetID", targetID, "pipelineConfigID", pipelineConfigID)
+		pcState.Updated = time.Now()
+		pcState.Status.State = provisionerapi.ConfigStatus_FAILED
+		attempts.GiveUp(err)
+		m.recordEvent(ctx, configstore.PushFailed, targetID, pipelineConfigID, err.Error())
+		return utils.UpdateObjectState(ctx, m.topo, target, pipelineKind, pcState, utils.PipelineAttemptsAspect, attempts)
+	}
 
 	info := artifacts[provisionerapi.P4InfoType]
 	binary := artifacts[provisionerapi.P4BinaryType]
@@ -212,12 +307,13 @@ func (m *Manager) reconcilePipelineConfiguration(ctx context.Context, target *to
 	}
 
 	// and then apply it to the device
+	m.recordEvent(ctx, configstore.PushStarted, targetID, pipelineConfigID, "")
 	newCookie := uint64(time.Now().UnixNano())
-	_, err = p4rtConn.SetForwardingPipelineConfig(ctx, &p4api.SetForwardingPipelineConfigRequest{
-		DeviceId:   stratumAgents.DeviceID,
+	err = setPipelineConfig(ctx, p4rtConn, targetID, &p4api.SetForwardingPipelineConfigRequest{
+		DeviceId:   deviceID,
 		Role:       provisionerRoleName,
 		ElectionId: electionID,
-		Action:     p4api.SetForwardingPipelineConfigRequest_VERIFY_AND_COMMIT,
+		Action:     p4api.SetForwardingPipelineConfigRequest_Action(p4api.SetForwardingPipelineConfigRequest_Action_value[mode]),
 		Config: &p4api.ForwardingPipelineConfig{
 			P4Info:         p4i,
 			P4DeviceConfig: binary,
@@ -226,18 +322,92 @@ func (m *Manager) reconcilePipelineConfiguration(ctx context.Context, target *to
 	})
 	if err != nil {
 		log.Warnw("Failed to Set forwarding pipeline config", "targetID", targetID, "error", err)
-		return err
+		m.recordEvent(ctx, configstore.PushFailed, targetID, pipelineConfigID, err.Error())
+		pcState.Updated = time.Now()
+		pcState.Status.State = provisionerapi.ConfigStatus_FAILED
+		if !attempts.Failed(utils.DefaultRetryPolicy, err) {
+			log.Warnw("Giving up applying device pipeline config", "targetID", targetID, "pipelineConfigID", pipelineConfigID, "attempts", attempts.Attempts)
+		}
+		return utils.UpdateObjectState(ctx, m.topo, target, pipelineKind, pcState, utils.PipelineAttemptsAspect, attempts)
 	}
 
 	// Update PipelineConfigState aspect
-	pcState.ConfigID = deviceConfigAspect.PipelineConfigID
+	pcState.ConfigID = pipelineConfigID
 	pcState.Updated = time.Now()
 	pcState.Status.State = provisionerapi.ConfigStatus_APPLIED
 	pcState.Cookie = newCookie
-	err = utils.UpdateObjectAspect(ctx, m.topo, target, "pipeline", pcState)
+	attempts.Succeeded()
+	m.recordEvent(ctx, configstore.PushSucceeded, targetID, pipelineConfigID, "")
+	err = utils.UpdateObjectState(ctx, m.topo, target, pipelineKind, pcState, utils.PipelineAttemptsAspect, attempts)
 	if err != nil {
 		return err
 	}
 	log.Infow("Device pipeline config is set successfully", "targetID", targetID, "Status", pcState.Status.State)
 	return nil
 }
+
+// Connects to the P4Runtime agent of the device and performs master arbitration for the provisioner role;
+// returns the client, the election ID and the P4Runtime device ID
+func (m *Manager) connect(ctx context.Context, target *topoapi.Object) (p4rtclient.Client, *p4api.Uint128, uint64, error) {
+	stratumAgents := &topoapi.StratumAgents{}
+	if err := target.GetAspect(stratumAgents); err != nil {
+		log.Warnw("Failed to extract stratum agents aspect", "error", err)
+		return nil, nil, 0, err
+	}
+
+	p4rtConn, err := m.conns.GetByTarget(ctx, target.ID)
+	if err != nil {
+		log.Warnw("Connection not found for target", "target ID", target.ID)
+		return nil, nil, 0, err
+	}
+
+	role := p4utils.NewStratumRole(provisionerRoleName, 0, []byte{}, false, true)
+	arbitrationCtx, span := tracing.Start(ctx, "PerformMasterArbitration", target.ID)
+	arbitrationResponse, err := p4rtConn.PerformMasterArbitration(arbitrationCtx, role)
+	tracing.End(span, err)
+	if err != nil {
+		log.Warnw("Failed to perform master arbitration", "error", err)
+		return nil, nil, 0, err
+	}
+
+	electionID := arbitrationResponse.Arbitration.ElectionId
+	log.Info(electionID)
+	return p4rtConn, electionID, stratumAgents.DeviceID, nil
+}
+
+// Sets the forwarding pipeline config of the target device, measuring and tracing the call
+func setPipelineConfig(ctx context.Context, conn p4rtclient.Client, targetID topoapi.ID, request *p4api.SetForwardingPipelineConfigRequest) error {
+	ctx, span := tracing.Start(ctx, "SetForwardingPipelineConfig", targetID, attribute.String("p4rt.action", request.Action.String()))
+	start := time.Now()
+	_, err := conn.SetForwardingPipelineConfig(ctx, request)
+	metrics.ObserveSetPipelineConfig(request.Action, start, err)
+	tracing.End(span, err)
+	return err
+}
+
+// Records the audit event concerning the pipeline config of the device
+func (m *Manager) recordEvent(ctx context.Context, eventType configstore.AuditEventType, targetID topoapi.ID, configID provisionerapi.ConfigID, message string) {
+	utils.RecordEvent(ctx, m.audit, &configstore.AuditEvent{
+		Type:     eventType,
+		TargetID: targetID,
+		ConfigID: configID,
+		Kind:     pipelineKind,
+		Message:  message,
+	})
+}
+
+// Returns the mode in which the pipeline config is to be applied
+func (m *Manager) applyMode(ctx context.Context, configID provisionerapi.ConfigID) (string, error) {
+	baseID, _, err := configstore.ParseRef(configID)
+	if err != nil {
+		return "", err
+	}
+	history, err := m.configStore.GetHistory(ctx, baseID)
+	if err != nil {
+		return "", err
+	}
+	if history.ApplyMode == "" {
+		return configstore.VerifyAndCommitMode, nil
+	}
+	return history.ApplyMode, nil
+}
@@ -0,0 +1,448 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rollout configuration rollout controller
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	"github.com/onosproject/device-provisioner/pkg/metrics"
+	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
+	"github.com/onosproject/device-provisioner/pkg/store/topo"
+	provisionerapi "github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/controller/v2"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"github.com/onosproject/onos-net-lib/pkg/realm"
+)
+
+var log = logging.GetLogger()
+
+const queueSize = 100
+
+// rolloutID identifies the rollouts reconciled by the controller
+type rolloutID string
+
+func (id rolloutID) String() string {
+	return string(id)
+}
+
+// NewManager returns a new rollout controller manager
+func NewManager(topo topo.Store, configStore configstore.ConfigStore, rollouts configstore.RolloutStore, policies configstore.PolicyStore, realmOptions *realm.Options) *Manager {
+	manager := &Manager{
+		topo:         topo,
+		configStore:  configStore,
+		rollouts:     rollouts,
+		policies:     policies,
+		realmOptions: realmOptions,
+		devices:      newDeviceIndex(),
+	}
+	return manager
+}
+
+// Manager advances the rollouts of configurations in waves, editing the DeviceConfig aspects of the devices
+// of each wave, and halts and rolls back rollouts whose devices fail to apply the new configuration
+type Manager struct {
+	topo         topo.Store
+	configStore  configstore.ConfigStore
+	rollouts     configstore.RolloutStore
+	policies     configstore.PolicyStore
+	realmOptions *realm.Options
+	devices      *deviceIndex
+	controller   *controller.Controller[rolloutID]
+	retries      utils.RetryTimers[rolloutID]
+	cancel       context.CancelFunc
+	mu           sync.Mutex
+	reconciles   utils.Reconciles
+}
+
+// Start starts the manager
+func (m *Manager) Start() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.cancel != nil {
+		return nil
+	}
+	m.reconciles.Resume()
+	rolloutController := controller.NewController(metrics.Reconciler(metrics.RolloutController, m.reconcile))
+	m.controller = rolloutController
+	ctx, cancel := context.WithCancel(context.Background())
+
+	rolloutCh := make(chan *configstore.Rollout, queueSize)
+	if err := m.rollouts.Watch(ctx, rolloutCh); err != nil {
+		cancel()
+		return err
+	}
+	eventCh := make(chan topoapi.Event, queueSize)
+	if err := m.topo.Watch(ctx, eventCh, utils.RealmQueryFilter(m.realmOptions)); err != nil {
+		cancel()
+		return err
+	}
+	m.cancel = cancel
+
+	go func() {
+		for rollout := range rolloutCh {
+			// the rollouts of other realms are left to their provisioners
+			if rollout.Realm != m.realmOptions.Value {
+				continue
+			}
+			m.devices.update(rollout)
+			if rollout.State.Done() {
+				continue
+			}
+			if err := rolloutController.Reconcile(rolloutID(rollout.ID)); err != nil {
+				log.Warnw("Failed to reconcile rollout", "rolloutID", rollout.ID, "error", err)
+			}
+		}
+	}()
+	// changes of the configuration state of the devices advance the rollouts they are part of
+	go func() {
+		for event := range eventCh {
+			if _, ok := event.Object.Obj.(*topoapi.Object_Entity); !ok {
+				continue
+			}
+			for _, id := range m.devices.lookup(event.Object.ID) {
+				if err := rolloutController.Reconcile(rolloutID(id)); err != nil {
+					log.Warnw("Failed to reconcile rollout", "rolloutID", id, "error", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops the manager, waiting for the in-flight reconciles to complete until the context is done
+func (m *Manager) Stop(ctx context.Context) error {
+	m.mu.Lock()
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
+	m.mu.Unlock()
+	m.retries.Stop()
+	return m.reconciles.Drain(ctx)
+}
+
+func (m *Manager) reconcile(ctx context.Context, request controller.Request[rolloutID]) controller.Directive[rolloutID] {
+	if !m.reconciles.Begin() {
+		return request.Ack()
+	}
+	defer m.reconciles.End()
+	id := request.ID
+	log.Infow("Reconciling rollout", "rolloutID", id)
+
+	rollout, err := m.rollouts.Get(ctx, string(id))
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.Warnw("Failed reconciling rollout", "rolloutID", id, "error", err)
+			return request.Retry(err)
+		}
+		// the rollout store does not stream removals, so deleted rollouts are dropped from the index here
+		m.devices.remove(string(id))
+		return request.Ack()
+	}
+	if rollout.Realm != m.realmOptions.Value {
+		return request.Ack()
+	}
+
+	var retryAt time.Time
+	switch rollout.State {
+	case configstore.RolloutPending:
+		err = m.startRollout(ctx, rollout)
+	case configstore.RolloutRunning:
+		retryAt, err = m.advanceRollout(ctx, rollout)
+	case configstore.RolloutRollingBack:
+		err = m.rollBack(ctx, rollout)
+	}
+	if err != nil {
+		log.Warnw("Failed reconciling rollout", "rolloutID", id, "error", err)
+		return request.Retry(err)
+	}
+	if !retryAt.IsZero() {
+		if m.retries.Schedule(id, retryAt, m.controller.Reconcile) {
+			log.Infow("Rollout soaking", "rolloutID", id, "until", retryAt)
+		}
+	}
+	return request.Ack()
+}
+
+// Selects the devices of the rollout and starts running it
+func (m *Manager) startRollout(ctx context.Context, rollout *configstore.Rollout) error {
+	policies, err := m.policies.List(ctx)
+	if err != nil {
+		log.Warnw("Unable to list assignment policies", "error", err)
+		return err
+	}
+	ch := make(chan *topoapi.Object)
+	if err = m.topo.Query(ctx, ch, utils.RealmQueryFilter(m.realmOptions)); err != nil {
+		log.Warnw("Unable to query realm objects", "error", err)
+		return err
+	}
+	devices := make([]topoapi.ID, 0)
+	for object := range ch {
+		if !rollout.Matches(object.Labels) {
+			continue
+		}
+		if deviceConfig, ok := utils.AssignedDeviceConfig(object, policies); ok && configIDOf(deviceConfig, rollout.Kind) == rollout.FromConfigID {
+			devices = append(devices, object.ID)
+		}
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i] < devices[j] })
+
+	log.Infow("Starting rollout", "rolloutID", rollout.ID, "devices", len(devices))
+	rollout.Devices = devices
+	rollout.State = configstore.RolloutRunning
+	return m.rollouts.Update(ctx, rollout)
+}
+
+// Advances the rollout to the next wave once the devices of the current wave settled and soaked; returns
+// the time at which the rollout is to be advanced again if it is soaking
+func (m *Manager) advanceRollout(ctx context.Context, rollout *configstore.Rollout) (time.Time, error) {
+	// make sure the devices of the current wave were moved, as the rollout may have been interrupted
+	for _, id := range rollout.CurrentWave() {
+		if err := m.setDesiredConfigID(ctx, id, rollout.Kind, rollout.FromConfigID, rollout.ToConfigID); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	toConfigID, err := utils.ResolveConfigID(ctx, m.configStore, rollout.ToConfigID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	settled, failures := true, len(rollout.Failed)
+	current := rollout.CurrentWave()
+	for _, id := range rollout.Moved() {
+		applied, failed, err := m.deviceState(ctx, id, rollout, toConfigID)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if failed && !utils.Contains(rollout.Failed, id) {
+			log.Warnw("Device failed to apply rollout configuration", "rolloutID", rollout.ID, "targetID", id)
+			rollout.Failed = append(rollout.Failed, id)
+		}
+		if !applied && !failed && utils.Contains(current, id) {
+			settled = false
+		}
+	}
+
+	if uint32(len(rollout.Failed)) > rollout.FailureThreshold {
+		log.Warnw("Halting rollout", "rolloutID", rollout.ID, "failed", rollout.Failed)
+		rollout.State = configstore.RolloutRollingBack
+		rollout.Message = fmt.Sprintf("%d devices failed to apply %s, exceeding the failure threshold of %d",
+			len(rollout.Failed), rollout.ToConfigID, rollout.FailureThreshold)
+		if err = m.rollouts.Update(ctx, rollout); err != nil {
+			return time.Time{}, err
+		}
+		return time.Time{}, m.rollBack(ctx, rollout)
+	}
+	if !settled {
+		// the configuration state changes of the devices will trigger the rollout again
+		if len(rollout.Failed) > failures {
+			return time.Time{}, m.rollouts.Update(ctx, rollout)
+		}
+		return time.Time{}, nil
+	}
+
+	if rollout.Wave > 0 {
+		if rollout.WaveSettled.IsZero() {
+			rollout.WaveSettled = time.Now()
+			if err = m.rollouts.Update(ctx, rollout); err != nil {
+				return time.Time{}, err
+			}
+		}
+		if soaked := rollout.WaveSettled.Add(rollout.SoakTime); time.Now().Before(soaked) {
+			return soaked, nil
+		}
+	}
+
+	if len(rollout.Moved()) == len(rollout.Devices) {
+		log.Infow("Rollout completed", "rolloutID", rollout.ID, "devices", len(rollout.Devices))
+		rollout.State = configstore.RolloutCompleted
+		rollout.Message = ""
+		return time.Time{}, m.rollouts.Update(ctx, rollout)
+	}
+
+	// record the start of the next wave before moving its devices
+	rollout.Wave++
+	rollout.WaveSettled = time.Time{}
+	if err = m.rollouts.Update(ctx, rollout); err != nil {
+		return time.Time{}, err
+	}
+	log.Infow("Starting rollout wave", "rolloutID", rollout.ID, "wave", rollout.Wave, "devices", rollout.CurrentWave())
+	for _, id := range rollout.CurrentWave() {
+		if err = m.setDesiredConfigID(ctx, id, rollout.Kind, rollout.FromConfigID, rollout.ToConfigID); err != nil {
+			return time.Time{}, err
+		}
+	}
+	return time.Time{}, nil
+}
+
+// Moves the devices of the halted rollout back to the original configuration
+func (m *Manager) rollBack(ctx context.Context, rollout *configstore.Rollout) error {
+	log.Infow("Rolling back rollout", "rolloutID", rollout.ID, "devices", rollout.Moved())
+	for _, id := range rollout.Moved() {
+		if err := m.setDesiredConfigID(ctx, id, rollout.Kind, rollout.ToConfigID, rollout.FromConfigID); err != nil {
+			return err
+		}
+	}
+	rollout.State = configstore.RolloutRolledBack
+	return m.rollouts.Update(ctx, rollout)
+}
+
+// Returns whether the device applied the given configuration revision or failed to apply it; devices which
+// no longer exist or were moved to another configuration by someone else count as applied
+func (m *Manager) deviceState(ctx context.Context, id topoapi.ID, rollout *configstore.Rollout, configID provisionerapi.ConfigID) (bool, bool, error) {
+	object, err := m.topo.Get(ctx, id)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return false, false, err
+		}
+		return true, false, nil
+	}
+	deviceConfig, err := utils.GetDeviceConfig(ctx, m.policies, object)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return false, false, err
+		}
+		return true, false, nil
+	}
+	if configIDOf(deviceConfig, rollout.Kind) != rollout.ToConfigID {
+		return true, false, nil
+	}
+
+	var stateConfigID provisionerapi.ConfigID
+	var state provisionerapi.ConfigStatus_State
+	if rollout.Kind == configstore.ChassisConfigKind {
+		ccState := &provisionerapi.ChassisConfigState{}
+		if err = object.GetAspect(ccState); err != nil {
+			return false, false, nil
+		}
+		stateConfigID, state = ccState.ConfigID, ccState.Status.State
+	} else {
+		pcState := &provisionerapi.PipelineConfigState{}
+		if err = object.GetAspect(pcState); err != nil {
+			return false, false, nil
+		}
+		stateConfigID, state = pcState.ConfigID, pcState.Status.State
+	}
+	if stateConfigID != configID {
+		return false, false, nil
+	}
+	return state == provisionerapi.ConfigStatus_APPLIED, state == provisionerapi.ConfigStatus_FAILED, nil
+}
+
+// Changes the configuration reference of the given kind in the DeviceConfig aspect of the device, provided
+// it still has the expected reference, so that changes made by someone else are left alone; devices following
+// the assignment policies get a DeviceConfig aspect with the configurations assigned to them, which is dropped
+// again once it matches the policies, e.g. when the rollout is rolled back or the policies are updated
+func (m *Manager) setDesiredConfigID(ctx context.Context, id topoapi.ID, kind string, expected provisionerapi.ConfigID, ref provisionerapi.ConfigID) error {
+	object, err := m.topo.Get(ctx, id)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+	policies, err := m.policies.List(ctx)
+	if err != nil {
+		log.Warnw("Unable to list assignment policies", "error", err)
+		return err
+	}
+	deviceConfig, ok := utils.AssignedDeviceConfig(object, policies)
+	if !ok {
+		return nil
+	}
+	if kind == configstore.ChassisConfigKind {
+		if deviceConfig.ChassisConfigID != expected {
+			return nil
+		}
+		deviceConfig.ChassisConfigID = ref
+	} else {
+		if deviceConfig.PipelineConfigID != expected {
+			return nil
+		}
+		deviceConfig.PipelineConfigID = ref
+	}
+	if err = utils.SetDeviceConfig(object, deviceConfig, policies); err != nil {
+		return err
+	}
+	log.Infow("Changing device configuration", "targetID", id, "kind", kind, "configID", ref)
+	return m.topo.Update(ctx, object)
+}
+
+// deviceIndex maps the devices moved by the running rollouts to the rollouts, so that the events of a device
+// trigger only the rollouts it is part of, without listing the rollouts for every topo event
+type deviceIndex struct {
+	mu      sync.RWMutex
+	moved   map[string][]topoapi.ID
+	running map[topoapi.ID]map[string]bool
+}
+
+func newDeviceIndex() *deviceIndex {
+	return &deviceIndex{
+		moved:   make(map[string][]topoapi.ID),
+		running: make(map[topoapi.ID]map[string]bool),
+	}
+}
+
+// Indexes the moved devices of the rollout if it is running, and drops it from the index otherwise
+func (i *deviceIndex) update(rollout *configstore.Rollout) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.removeLocked(rollout.ID)
+	if rollout.State != configstore.RolloutRunning {
+		return
+	}
+	moved := rollout.Moved()
+	i.moved[rollout.ID] = moved
+	for _, id := range moved {
+		if i.running[id] == nil {
+			i.running[id] = make(map[string]bool)
+		}
+		i.running[id][rollout.ID] = true
+	}
+}
+
+// Drops the rollout from the index
+func (i *deviceIndex) remove(rolloutID string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.removeLocked(rolloutID)
+}
+
+func (i *deviceIndex) removeLocked(rolloutID string) {
+	for _, id := range i.moved[rolloutID] {
+		delete(i.running[id], rolloutID)
+		if len(i.running[id]) == 0 {
+			delete(i.running, id)
+		}
+	}
+	delete(i.moved, rolloutID)
+}
+
+// Returns the IDs of the running rollouts which moved the given device
+func (i *deviceIndex) lookup(id topoapi.ID) []string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	rolloutIDs := make([]string, 0, len(i.running[id]))
+	for rolloutID := range i.running[id] {
+		rolloutIDs = append(rolloutIDs, rolloutID)
+	}
+	return rolloutIDs
+}
+
+// Returns the configuration reference of the given kind from the device config
+func configIDOf(deviceConfig *provisionerapi.DeviceConfig, kind string) provisionerapi.ConfigID {
+	if kind == configstore.ChassisConfigKind {
+		return deviceConfig.ChassisConfigID
+	}
+	return deviceConfig.PipelineConfigID
+}
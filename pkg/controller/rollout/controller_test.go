@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/atomix/go-sdk/pkg/test"
+	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
+	"github.com/onosproject/device-provisioner/pkg/store/topo/topotest"
+	provisionerapi "github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/controller/v2"
+	"github.com/onosproject/onos-net-lib/pkg/realm"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	fromConfigID provisionerapi.ConfigID = "p4_leaf@1"
+	toConfigID   provisionerapi.ConfigID = "p4_leaf@2"
+)
+
+var testRealm = &realm.Options{Label: "pod", Value: "pod-1"}
+
+func newTestManager(t *testing.T, objects ...*topoapi.Object) (*Manager, *topotest.Store) {
+	cluster := test.NewClient()
+	t.Cleanup(cluster.Close)
+	backend, err := configstore.NewInlineBackend(cluster, 1024)
+	assert.NoError(t, err)
+	configStore, err := configstore.NewAtomixStore(cluster, backend, configstore.DefaultRevisionRetention)
+	assert.NoError(t, err)
+	rollouts, err := configstore.NewAtomixRolloutStore(cluster)
+	assert.NoError(t, err)
+	policies, err := configstore.NewAtomixPolicyStore(cluster)
+	assert.NoError(t, err)
+	topo := topotest.NewStore(objects...)
+	return NewManager(topo, configStore, rollouts, policies, testRealm), topo
+}
+
+// Returns a device of the realm with the given pipeline configuration and, unless pending, the state of applying it
+func newDevice(t *testing.T, id topoapi.ID, configID provisionerapi.ConfigID, state provisionerapi.ConfigStatus_State) *topoapi.Object {
+	object := &topoapi.Object{ID: id, Type: topoapi.Object_ENTITY, Obj: &topoapi.Object_Entity{Entity: &topoapi.Entity{}},
+		Labels: map[string]string{testRealm.Label: testRealm.Value, "role": "leaf"}}
+	assert.NoError(t, object.SetAspect(&topoapi.StratumAgents{}))
+	assert.NoError(t, object.SetAspect(&provisionerapi.DeviceConfig{PipelineConfigID: configID}))
+	if state != provisionerapi.ConfigStatus_PENDING {
+		assert.NoError(t, object.SetAspect(&provisionerapi.PipelineConfigState{ConfigID: configID, Status: provisionerapi.ConfigStatus{State: state}}))
+	}
+	return object
+}
+
+// Stores a running rollout of the leaves which started the given number of waves
+func newRollout(t *testing.T, m *Manager, wave uint32, threshold uint32, soakTime time.Duration) *configstore.Rollout {
+	ctx := context.TODO()
+	rollout := &configstore.Rollout{ID: "leaf-upgrade", Realm: testRealm.Value, Kind: configstore.PipelineConfigKind, Selector: map[string]string{"role": "leaf"},
+		FromConfigID: fromConfigID, ToConfigID: toConfigID, BatchSize: 2, SoakTime: soakTime, FailureThreshold: threshold}
+	assert.NoError(t, m.rollouts.Create(ctx, rollout))
+	rollout.State = configstore.RolloutRunning
+	rollout.Devices = []topoapi.ID{"leaf1", "leaf2", "leaf3"}
+	rollout.Wave = wave
+	assert.NoError(t, m.rollouts.Update(ctx, rollout))
+	return rollout
+}
+
+// Returns the pipeline configurations of the devices
+func desiredConfigIDs(t *testing.T, topo *topotest.Store, ids ...topoapi.ID) []provisionerapi.ConfigID {
+	configIDs := make([]provisionerapi.ConfigID, 0, len(ids))
+	for _, id := range ids {
+		object, err := topo.Get(context.TODO(), id)
+		assert.NoError(t, err)
+		deviceConfig, ok := utils.AssignedDeviceConfig(object, nil)
+		assert.True(t, ok)
+		configIDs = append(configIDs, deviceConfig.PipelineConfigID)
+	}
+	return configIDs
+}
+
+func TestAdvanceRollout(t *testing.T) {
+	applied, failed, pending := provisionerapi.ConfigStatus_APPLIED, provisionerapi.ConfigStatus_FAILED, provisionerapi.ConfigStatus_PENDING
+	tests := []struct {
+		name      string
+		wave      uint32
+		states    []provisionerapi.ConfigStatus_State
+		threshold uint32
+		soakTime  time.Duration
+		state     configstore.RolloutState
+		nextWave  uint32
+		soaking   bool
+		failed    []topoapi.ID
+		configIDs []provisionerapi.ConfigID
+	}{
+		{
+			name:      "halt",
+			wave:      1,
+			states:    []provisionerapi.ConfigStatus_State{applied, failed},
+			state:     configstore.RolloutRolledBack,
+			nextWave:  1,
+			failed:    []topoapi.ID{"leaf2"},
+			configIDs: []provisionerapi.ConfigID{fromConfigID, fromConfigID, fromConfigID},
+		},
+		{
+			name:      "failures within threshold",
+			wave:      1,
+			states:    []provisionerapi.ConfigStatus_State{applied, failed},
+			threshold: 1,
+			state:     configstore.RolloutRunning,
+			nextWave:  2,
+			failed:    []topoapi.ID{"leaf2"},
+			configIDs: []provisionerapi.ConfigID{toConfigID, toConfigID, toConfigID},
+		},
+		{
+			name:      "unsettled",
+			wave:      1,
+			states:    []provisionerapi.ConfigStatus_State{applied, pending},
+			state:     configstore.RolloutRunning,
+			nextWave:  1,
+			configIDs: []provisionerapi.ConfigID{toConfigID, toConfigID, fromConfigID},
+		},
+		{
+			name:      "soak",
+			wave:      1,
+			states:    []provisionerapi.ConfigStatus_State{applied, applied},
+			soakTime:  time.Hour,
+			state:     configstore.RolloutRunning,
+			nextWave:  1,
+			soaking:   true,
+			configIDs: []provisionerapi.ConfigID{toConfigID, toConfigID, fromConfigID},
+		},
+		{
+			name:      "complete",
+			wave:      2,
+			states:    []provisionerapi.ConfigStatus_State{applied, applied, applied},
+			state:     configstore.RolloutCompleted,
+			nextWave:  2,
+			configIDs: []provisionerapi.ConfigID{toConfigID, toConfigID, toConfigID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := []*topoapi.Object{
+				newDevice(t, "leaf1", fromConfigID, applied),
+				newDevice(t, "leaf2", fromConfigID, applied),
+				newDevice(t, "leaf3", fromConfigID, applied),
+			}
+			// the devices of the started waves were moved and applied the new configuration or not
+			for i, state := range tt.states {
+				objects[i] = newDevice(t, objects[i].ID, toConfigID, state)
+			}
+			m, topo := newTestManager(t, objects...)
+			rollout := newRollout(t, m, tt.wave, tt.threshold, tt.soakTime)
+
+			ctx := context.TODO()
+			retryAt, err := m.advanceRollout(ctx, rollout)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.soaking, retryAt.After(time.Now()))
+
+			stored, err := m.rollouts.Get(ctx, rollout.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.state, stored.State)
+			assert.Equal(t, tt.nextWave, stored.Wave)
+			assert.Equal(t, tt.failed, stored.Failed)
+			if tt.soaking {
+				assert.False(t, stored.WaveSettled.IsZero())
+			}
+			assert.Equal(t, tt.configIDs, desiredConfigIDs(t, topo, "leaf1", "leaf2", "leaf3"))
+		})
+	}
+}
+
+func TestRollBack(t *testing.T) {
+	// devices changed by someone else during the rollout are left alone
+	m, topo := newTestManager(t,
+		newDevice(t, "leaf1", toConfigID, provisionerapi.ConfigStatus_APPLIED),
+		newDevice(t, "leaf2", "p4_leaf@3", provisionerapi.ConfigStatus_APPLIED),
+		newDevice(t, "leaf3", fromConfigID, provisionerapi.ConfigStatus_APPLIED))
+	rollout := newRollout(t, m, 1, 0, 0)
+	rollout.State = configstore.RolloutRollingBack
+
+	ctx := context.TODO()
+	assert.NoError(t, m.rollBack(ctx, rollout))
+	stored, err := m.rollouts.Get(ctx, rollout.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, configstore.RolloutRolledBack, stored.State)
+	assert.Equal(t, []provisionerapi.ConfigID{fromConfigID, "p4_leaf@3", fromConfigID},
+		desiredConfigIDs(t, topo, "leaf1", "leaf2", "leaf3"))
+}
+
+func TestReconcileOtherRealm(t *testing.T) {
+	// the rollouts of other realms are left to the provisioners of those realms
+	m, topo := newTestManager(t, newDevice(t, "leaf1", fromConfigID, provisionerapi.ConfigStatus_APPLIED))
+	ctx := context.TODO()
+	rollout := &configstore.Rollout{ID: "leaf-upgrade", Realm: "pod-2", Kind: configstore.PipelineConfigKind,
+		FromConfigID: fromConfigID, ToConfigID: toConfigID, BatchSize: 1}
+	assert.NoError(t, m.rollouts.Create(ctx, rollout))
+
+	m.reconcile(ctx, controller.Request[rolloutID]{ID: "leaf-upgrade"})
+	stored, err := m.rollouts.Get(ctx, rollout.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, configstore.RolloutPending, stored.State)
+	assert.Empty(t, stored.Devices)
+	assert.Equal(t, []provisionerapi.ConfigID{fromConfigID}, desiredConfigIDs(t, topo, "leaf1"))
+}
+
+func TestDeviceIndex(t *testing.T) {
+	index := newDeviceIndex()
+	rollout := &configstore.Rollout{ID: "leaf-upgrade", State: configstore.RolloutRunning, BatchSize: 2,
+		Devices: []topoapi.ID{"leaf1", "leaf2", "leaf3"}, Wave: 1}
+	index.update(rollout)
+	index.update(&configstore.Rollout{ID: "spine-upgrade", State: configstore.RolloutRunning, BatchSize: 1,
+		Devices: []topoapi.ID{"spine1", "leaf1"}, Wave: 2})
+	assert.ElementsMatch(t, []string{"leaf-upgrade", "spine-upgrade"}, index.lookup("leaf1"))
+	assert.Equal(t, []string{"leaf-upgrade"}, index.lookup("leaf2"))
+	assert.Empty(t, index.lookup("leaf3"))
+
+	// the devices of each new wave are indexed, and rollouts which are no longer running are dropped
+	rollout.Wave = 2
+	index.update(rollout)
+	assert.Equal(t, []string{"leaf-upgrade"}, index.lookup("leaf3"))
+	rollout.State = configstore.RolloutCompleted
+	index.update(rollout)
+	assert.Equal(t, []string{"spine-upgrade"}, index.lookup("leaf1"))
+	assert.Empty(t, index.lookup("leaf3"))
+	index.remove("spine-upgrade")
+	assert.Empty(t, index.lookup("leaf1"))
+	assert.Empty(t, index.running)
+}
@@ -8,7 +8,10 @@ package target
 import (
 	"context"
 	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	"github.com/onosproject/device-provisioner/pkg/metrics"
+	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
 	"github.com/onosproject/device-provisioner/pkg/store/topo"
+	"github.com/onosproject/device-provisioner/pkg/tracing"
 	topoapi "github.com/onosproject/onos-api/go/onos/topo"
 	"github.com/onosproject/onos-lib-go/pkg/controller/v2"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
@@ -27,11 +30,13 @@ const (
 )
 
 // NewManager returns a new p4rt connection reconciler
-func NewManager(topo topo.Store, conns p4rtclient.ConnManager, realmOptions *realm.Options) *Manager {
+func NewManager(topo topo.Store, conns p4rtclient.ConnManager, policies configstore.PolicyStore, realmOptions *realm.Options) *Manager {
 	manager := &Manager{
 		conns:        conns,
 		topo:         topo,
+		policyCache:  utils.NewPolicyCache(policies),
 		realmOptions: realmOptions,
+		targets:      make(map[topoapi.ID]struct{}),
 	}
 
 	return manager
@@ -41,10 +46,14 @@ func NewManager(topo topo.Store, conns p4rtclient.ConnManager, realmOptions *rea
 type Manager struct {
 	conns        p4rtclient.ConnManager
 	topo         topo.Store
+	policyCache  *utils.PolicyCache
 	realmOptions *realm.Options
 	cancel       context.CancelFunc
 	mu           sync.Mutex
 	connCh       chan p4rtclient.Conn
+	reconciles   utils.Reconciles
+	targetsMu    sync.Mutex
+	targets      map[topoapi.ID]struct{}
 }
 
 // Start starts the reconciler
@@ -55,7 +64,8 @@ func (m *Manager) Start() error {
 		return nil
 	}
 
-	targetController := controller.NewController(m.reconcile)
+	m.reconciles.Resume()
+	targetController := controller.NewController(metrics.Reconciler(metrics.TargetController, tracing.Reconciler(metrics.TargetController, m.reconcile)))
 	m.connCh = make(chan p4rtclient.Conn, queueSize)
 	ctx, cancel := context.WithCancel(context.Background())
 	err := m.conns.Watch(ctx, m.connCh)
@@ -94,11 +104,48 @@ func (m *Manager) Start() error {
 			}
 		}
 	}()
+	go utils.WatchPolicies(ctx, m.topo, m.policyCache, m.realmOptions, targetController.Reconcile)
 	return nil
 }
 
+// Ready returns an error unless the manager is watching the assignment policies; the topo watches are reported
+// by the topo store
+func (m *Manager) Ready(ctx context.Context) error {
+	return m.policyCache.Ready(ctx)
+}
+
+// Stop stops the manager, waiting for the in-flight reconciles to complete until the context is done, and then
+// disconnects from all targets; closing the P4Runtime stream channels releases the mastership of the provisioner
+func (m *Manager) Stop(ctx context.Context) error {
+	m.mu.Lock()
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
+	m.mu.Unlock()
+	err := m.reconciles.Drain(ctx)
+	if err != nil {
+		log.Warnw("Disconnecting from targets with reconciles still in flight", "error", err)
+	}
+
+	m.targetsMu.Lock()
+	defer m.targetsMu.Unlock()
+	for targetID := range m.targets {
+		log.Infow("Disconnecting from Target", "targetID", targetID)
+		if err := m.conns.Disconnect(ctx, targetID); err != nil && !errors.IsNotFound(err) {
+			log.Warnw("Failed disconnecting from Target", "targetID", targetID, "error", err)
+		}
+		delete(m.targets, targetID)
+	}
+	return err
+}
+
 // Reconcile reconciles a connection for a P4RT target
 func (m *Manager) reconcile(ctx context.Context, request controller.Request[topoapi.ID]) controller.Directive[topoapi.ID] {
+	if !m.reconciles.Begin() {
+		return request.Ack()
+	}
+	defer m.reconciles.End()
 	targetID := request.ID
 	log.Infow("Reconciling Target Connections", "targetID", targetID)
 	target, err := m.topo.Get(ctx, targetID)
@@ -110,6 +157,23 @@ func (m *Manager) reconcile(ctx context.Context, request controller.Request[topo
 		return m.disconnect(ctx, request, targetID)
 	}
 
+	// the realm includes devices which no configuration is assigned to; those are not connected
+	if _, err := utils.GetDeviceConfig(ctx, m.policyCache, target); err != nil {
+		if !errors.IsNotFound(err) {
+			log.Warnw("Failed retrieving device config", "targetID", targetID, "error", err)
+			return request.Retry(err)
+		}
+		m.targetsMu.Lock()
+		_, connected := m.targets[targetID]
+		m.targetsMu.Unlock()
+		if !connected {
+			log.Debugw("No device config is assigned", "targetID", targetID)
+			return request.Ack()
+		}
+		log.Infow("No device config is assigned anymore", "targetID", targetID)
+		return m.disconnect(ctx, request, targetID)
+	}
+
 	stratumAgents := &topoapi.StratumAgents{}
 	if err := target.GetAspect(stratumAgents); err != nil {
 		log.Errorw("Failed to extract stratum agents aspect", "targetID", targetID, "error", err)
@@ -140,8 +204,10 @@ func (m *Manager) connect(ctx context.Context, request controller.Request[topoap
 			return request.Retry(err)
 		}
 		log.Warnw("Failed connecting to Target", "targetID", dest.TargetID, "error", err)
-		return request.Ack()
 	}
+	m.targetsMu.Lock()
+	m.targets[dest.TargetID] = struct{}{}
+	m.targetsMu.Unlock()
 	return request.Ack()
 }
 
@@ -153,7 +219,9 @@ func (m *Manager) disconnect(ctx context.Context, request controller.Request[top
 			return request.Retry(err)
 		}
 		log.Warnw("Failed disconnecting from Target", "targetID", targetID, "error", err)
-		return request.Ack()
 	}
+	m.targetsMu.Lock()
+	delete(m.targets, targetID)
+	m.targetsMu.Unlock()
 	return request.Ack()
 }
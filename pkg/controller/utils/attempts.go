@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
+	"github.com/onosproject/device-provisioner/pkg/store/topo"
+	"github.com/onosproject/device-provisioner/pkg/tracing"
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// PipelineAttemptsAspect is the type of the aspect tracking attempts to apply pipeline configurations
+	PipelineAttemptsAspect = "onos.provisioner.PipelineConfigAttempts"
+	// ChassisAttemptsAspect is the type of the aspect tracking attempts to apply chassis configurations
+	ChassisAttemptsAspect = "onos.provisioner.ChassisConfigAttempts"
+)
+
+// RetryPolicy bounds the re-attempts to apply configurations which failed to be applied
+type RetryPolicy struct {
+	// MaxAttempts is the number of failed attempts after which the provisioner gives up
+	MaxAttempts uint32
+	// InitialInterval is the delay before the first re-attempt; the delay doubles with every failed attempt
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between re-attempts
+	MaxInterval time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy used by the controllers
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:     8,
+	InitialInterval: 10 * time.Second,
+	MaxInterval:     10 * time.Minute,
+}
+
+// Interval returns the delay before the re-attempt following the given number of failed attempts
+func (p RetryPolicy) Interval(attempts uint32) time.Duration {
+	interval := p.InitialInterval
+	for i := uint32(1); i < attempts && interval < p.MaxInterval; i++ {
+		interval *= 2
+	}
+	if interval > p.MaxInterval {
+		interval = p.MaxInterval
+	}
+	return interval
+}
+
+// ConfigAttempts tracks the attempts to apply a configuration to a device; it is kept in a JSON aspect alongside
+// the configuration state aspect, as the state aspects have no room for it
+type ConfigAttempts struct {
+	// ConfigID is the configuration revision to which the attempts apply
+	ConfigID provisioner.ConfigID `json:"configID"`
+	// Attempts is the number of consecutive failed attempts
+	Attempts uint32 `json:"attempts"`
+	// NextAttempt is the time of the next scheduled re-attempt
+	NextAttempt time.Time `json:"nextAttempt,omitempty"`
+	// GaveUp indicates that the retry limit was reached and the configuration is no longer re-attempted
+	GaveUp bool `json:"gaveUp,omitempty"`
+	// LastError is the error message of the last failed attempt
+	LastError string `json:"lastError,omitempty"`
+	// LastErrorCode is the gRPC status code of the last failed attempt
+	LastErrorCode string `json:"lastErrorCode,omitempty"`
+	// LastAttempt is the time of the last attempt
+	LastAttempt time.Time `json:"lastAttempt,omitempty"`
+	// LastSuccess is the time of the last successful attempt
+	LastSuccess time.Time `json:"lastSuccess,omitempty"`
+	// AppliedConfigID is the configuration revision last applied to the device; unlike the configuration of
+	// the state aspect, which is the one being applied, it is retained while another configuration is pending
+	AppliedConfigID provisioner.ConfigID `json:"appliedConfigID,omitempty"`
+	// AppliedDigest is the digest of the configuration content last applied to the device, as rendered for
+	// the device in case of templated configurations; the state aspects have no room for it
+	AppliedDigest string `json:"appliedDigest,omitempty"`
+}
+
+// GetConfigAttempts returns the attempts tracked by the given aspect for the specified configuration; the attempts
+// are reset if they were tracked for another configuration, retaining only the times of the last attempt and success
+// and the last applied configuration
+func GetConfigAttempts(object *topoapi.Object, aspectType string, configID provisioner.ConfigID) *ConfigAttempts {
+	attempts := &ConfigAttempts{}
+	if data := object.GetAspectBytes(aspectType); data != nil {
+		if err := json.Unmarshal(data, attempts); err != nil {
+			log.Warnw("Unable to decode config attempts aspect", "targetID", object.ID, "aspect", aspectType, "error", err)
+		}
+	}
+	if attempts.ConfigID != configID {
+		attempts = &ConfigAttempts{
+			ConfigID:        configID,
+			LastAttempt:     attempts.LastAttempt,
+			LastSuccess:     attempts.LastSuccess,
+			AppliedConfigID: attempts.AppliedConfigID,
+		}
+	}
+	return attempts
+}
+
+// Failed records a failed attempt and schedules the next attempt according to the policy, or gives up if the
+// policy limit has been reached; returns true if the next attempt was scheduled
+func (a *ConfigAttempts) Failed(policy RetryPolicy, err error) bool {
+	a.recordFailure(err)
+	if a.Attempts >= policy.MaxAttempts {
+		a.GaveUp = true
+		a.NextAttempt = time.Time{}
+		return false
+	}
+	a.NextAttempt = time.Now().Add(policy.Interval(a.Attempts))
+	return true
+}
+
+// GiveUp records a failed attempt which is not to be re-attempted
+func (a *ConfigAttempts) GiveUp(err error) {
+	a.recordFailure(err)
+	a.GaveUp = true
+	a.NextAttempt = time.Time{}
+}
+
+// Succeeded records a successful attempt
+func (a *ConfigAttempts) Succeeded() {
+	a.Attempts = 0
+	a.NextAttempt = time.Time{}
+	a.GaveUp = false
+	a.LastError = ""
+	a.LastErrorCode = ""
+	a.LastAttempt = time.Now()
+	a.LastSuccess = a.LastAttempt
+	a.AppliedConfigID = a.ConfigID
+}
+
+func (a *ConfigAttempts) recordFailure(err error) {
+	a.Attempts++
+	a.LastAttempt = time.Now()
+	a.LastError = err.Error()
+	a.LastErrorCode = ErrorCode(err).String()
+}
+
+// ErrorCode returns the gRPC status code of the error; errors of the device APIs carry it already
+func ErrorCode(err error) codes.Code {
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+	if configstore.IsDataLoss(err) {
+		return codes.DataLoss
+	}
+	return errors.Status(err).Code()
+}
+
+// RetryScheduledError indicates that a failed configuration is to be re-attempted at the given time
+type RetryScheduledError struct {
+	At time.Time
+}
+
+func (e *RetryScheduledError) Error() string {
+	return fmt.Sprintf("re-attempt scheduled at %s", e.At.Format(time.RFC3339))
+}
+
+// RetryScheduled returns the time of the scheduled re-attempt if the error indicates one
+func RetryScheduled(err error) (time.Time, bool) {
+	if e, ok := err.(*RetryScheduledError); ok {
+		return e.At, true
+	}
+	return time.Time{}, false
+}
+
+// RetryTimers keeps at most one timer for the scheduled re-attempt of each object; every reconcile of an object
+// awaiting its re-attempt would otherwise start another timer, which cannot be cancelled once started
+type RetryTimers[I comparable] struct {
+	mu     sync.Mutex
+	timers map[I]*retryTimer
+}
+
+type retryTimer struct {
+	at    time.Time
+	timer *time.Timer
+}
+
+// Schedule arranges for the object to be reconciled at the given time, replacing the timer of the object if it
+// was scheduled for another time; returns false if the object was already scheduled for the given time
+func (r *RetryTimers[I]) Schedule(id I, at time.Time, reconcile func(I) error) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.timers == nil {
+		r.timers = make(map[I]*retryTimer)
+	}
+	if t, ok := r.timers[id]; ok {
+		if t.at.Equal(at) {
+			return false
+		}
+		t.timer.Stop()
+	}
+	t := &retryTimer{at: at}
+	t.timer = time.AfterFunc(time.Until(at), func() {
+		r.mu.Lock()
+		if r.timers[id] == t {
+			delete(r.timers, id)
+		}
+		r.mu.Unlock()
+		if err := reconcile(id); err != nil {
+			log.Warnw("Failed to reconcile object", "objectID", id, "error", err)
+		}
+	})
+	r.timers[id] = t
+	return true
+}
+
+// Stop stops the timers of all objects
+func (r *RetryTimers[I]) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, t := range r.timers {
+		t.timer.Stop()
+		delete(r.timers, id)
+	}
+}
+
+// UpdateObjectState updates the topo object with the specified configuration state aspect and the attempts
+// tracked for it, using a single update
+func UpdateObjectState(ctx context.Context, topo topo.Store, object *topoapi.Object, kind string, state proto.Message, aspectType string, attempts *ConfigAttempts) (err error) {
+	ctx, span := tracing.Start(ctx, "UpdateObjectState", object.ID, attribute.String("onos.config.kind", kind))
+	defer func() { tracing.End(span, err) }()
+
+	log.Infow("Updating state", "kind", kind, "targetID", object.ID)
+	entity, err := topo.Get(ctx, object.ID)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.Warnw("Unable to get object", "targetID", object.ID, "error", err)
+			return err
+		}
+		log.Warnw("Cannot find target object", "targetID", object.ID)
+		return nil
+	}
+
+	if err = entity.SetAspect(state); err != nil {
+		log.Warnw("Unable to set aspect", "kind", kind, "targetID", object.ID, "error", err)
+		return err
+	}
+	data, err := json.Marshal(attempts)
+	if err != nil {
+		return err
+	}
+	if err = entity.SetAspectBytes(aspectType, data); err != nil {
+		log.Warnw("Unable to set aspect", "kind", kind, "targetID", object.ID, "error", err)
+		return err
+	}
+	err = topo.Update(ctx, entity)
+	if err != nil {
+		if !errors.IsNotFound(err) && !errors.IsConflict(err) {
+			log.Warnw("Unable to update configuration state for object", "kind", kind, "targetID", object.ID, "error", err)
+			return err
+		}
+		log.Warnw("Write conflict updating entity state", "kind", kind, "targetID", object.ID, "error", err)
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyInterval(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 8, InitialInterval: 10 * time.Second, MaxInterval: time.Minute}
+	tests := []struct {
+		attempts uint32
+		interval time.Duration
+	}{
+		{attempts: 0, interval: 10 * time.Second},
+		{attempts: 1, interval: 10 * time.Second},
+		{attempts: 2, interval: 20 * time.Second},
+		{attempts: 3, interval: 40 * time.Second},
+		{attempts: 4, interval: time.Minute},
+		{attempts: 5, interval: time.Minute},
+		{attempts: 1000, interval: time.Minute},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.interval, policy.Interval(tt.attempts), "attempts %d", tt.attempts)
+	}
+}
+
+func TestConfigAttemptsFailed(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialInterval: time.Second, MaxInterval: time.Minute}
+	attempts := &ConfigAttempts{ConfigID: "p4_leaf@1"}
+	err := errors.NewUnavailable("device unreachable")
+
+	// the re-attempts back off until the limit is reached
+	for i, interval := range []time.Duration{time.Second, 2 * time.Second} {
+		start := time.Now()
+		assert.True(t, attempts.Failed(policy, err))
+		assert.Equal(t, uint32(i+1), attempts.Attempts)
+		assert.False(t, attempts.GaveUp)
+		assert.False(t, attempts.NextAttempt.Before(start.Add(interval)))
+		assert.False(t, attempts.NextAttempt.After(time.Now().Add(interval)))
+	}
+	assert.False(t, attempts.Failed(policy, err))
+	assert.Equal(t, uint32(3), attempts.Attempts)
+	assert.True(t, attempts.GaveUp)
+	assert.True(t, attempts.NextAttempt.IsZero())
+	assert.Equal(t, "device unreachable", attempts.LastError)
+	assert.Equal(t, "Unavailable", attempts.LastErrorCode)
+
+	// a successful attempt resets the count
+	attempts.Succeeded()
+	assert.Equal(t, uint32(0), attempts.Attempts)
+	assert.False(t, attempts.GaveUp)
+	assert.Equal(t, attempts.ConfigID, attempts.AppliedConfigID)
+	assert.True(t, attempts.Failed(policy, err))
+}
+
+func TestRetryTimers(t *testing.T) {
+	var retries RetryTimers[string]
+	ch := make(chan string, 10)
+	reconcile := func(id string) error {
+		ch <- id
+		return nil
+	}
+
+	// repeated reconciles awaiting the same re-attempt keep a single timer
+	at := time.Now().Add(50 * time.Millisecond)
+	assert.True(t, retries.Schedule("leaf1", at, reconcile))
+	assert.False(t, retries.Schedule("leaf1", at, reconcile))
+	assert.True(t, retries.Schedule("leaf2", at, reconcile))
+	// rescheduling replaces the timer
+	assert.True(t, retries.Schedule("leaf2", at.Add(50*time.Millisecond), reconcile))
+	assert.Equal(t, "leaf1", <-ch)
+	assert.Equal(t, "leaf2", <-ch)
+	select {
+	case id := <-ch:
+		assert.Fail(t, "unexpected reconcile", id)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// fired timers are dropped, and stopped timers do not fire
+	assert.True(t, retries.Schedule("leaf1", at, reconcile))
+	assert.Equal(t, "leaf1", <-ch)
+	assert.True(t, retries.Schedule("leaf1", time.Now().Add(50*time.Millisecond), reconcile))
+	retries.Stop()
+	select {
+	case id := <-ch:
+		assert.Fail(t, "unexpected reconcile", id)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+
+	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
+	"github.com/onosproject/device-provisioner/pkg/store/topo"
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-net-lib/pkg/realm"
+)
+
+// RecordEvent records the event in the audit log; failing to record it is logged, but does not fail the caller,
+// so that the provisioning does not depend on the audit log
+func RecordEvent(ctx context.Context, audit configstore.AuditStore, event *configstore.AuditEvent) {
+	if err := audit.Record(ctx, event); err != nil {
+		log.Warnw("Unable to record audit event", "type", event.Type, "targetID", event.TargetID, "configID", event.ConfigID, "error", err)
+	}
+}
+
+// configState is the configuration state of one kind of configuration on a device
+type configState struct {
+	configID provisioner.ConfigID
+	state    provisioner.ConfigStatus_State
+}
+
+// WatchStateTransitions records the transitions of the configuration state of the devices in the realm in the
+// audit log until the context is done; the states of the existing devices are taken as the starting point
+func WatchStateTransitions(ctx context.Context, topo topo.Store, audit configstore.AuditStore, realmOptions *realm.Options) error {
+	ch := make(chan topoapi.Event)
+	if err := topo.Watch(ctx, ch, RealmQueryFilter(realmOptions)); err != nil {
+		return err
+	}
+	go func() {
+		states := make(map[topoapi.ID]map[string]configState)
+		for event := range ch {
+			object := event.Object
+			if event.Type == topoapi.EventType_REMOVED {
+				delete(states, object.ID)
+				continue
+			}
+			current := make(map[string]configState)
+			pcState := &provisioner.PipelineConfigState{}
+			if err := object.GetAspect(pcState); err == nil {
+				current[configstore.PipelineConfigKind] = configState{configID: pcState.ConfigID, state: pcState.Status.State}
+			}
+			ccState := &provisioner.ChassisConfigState{}
+			if err := object.GetAspect(ccState); err == nil {
+				current[configstore.ChassisConfigKind] = configState{configID: ccState.ConfigID, state: ccState.Status.State}
+			}
+			previous, known := states[object.ID]
+			states[object.ID] = current
+			if !known && event.Type == topoapi.EventType_NONE {
+				continue
+			}
+			for kind, state := range current {
+				if state == previous[kind] {
+					continue
+				}
+				RecordEvent(ctx, audit, &configstore.AuditEvent{
+					Type:     configstore.StateChanged,
+					TargetID: object.ID,
+					ConfigID: state.configID,
+					Kind:     kind,
+					State:    state.state.String(),
+				})
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"sync"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// Reconciles tracks the in-flight reconciles of a controller, so that they can be drained when the controller
+// is stopped instead of being cut off while pushing a configuration to a device
+type Reconciles struct {
+	mu       sync.Mutex
+	wg       sync.WaitGroup
+	draining bool
+}
+
+// Begin registers the start of a reconcile; returns false once the reconciles are being drained, in which case
+// the reconcile must not proceed. Every reconcile which has begun must be ended by calling End.
+func (r *Reconciles) Begin() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.draining {
+		return false
+	}
+	r.wg.Add(1)
+	return true
+}
+
+// End registers the completion of a reconcile
+func (r *Reconciles) End() {
+	r.wg.Done()
+}
+
+// Drain stops admitting new reconciles and waits for the in-flight ones to complete; returns a timeout error
+// if they do not complete before the context is done
+func (r *Reconciles) Drain(ctx context.Context) error {
+	r.mu.Lock()
+	r.draining = true
+	r.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return errors.NewTimeout("in-flight reconciles did not complete: %v", ctx.Err())
+	}
+}
+
+// Resume admits reconciles again after they were drained
+func (r *Reconciles) Resume() {
+	r.mu.Lock()
+	r.draining = false
+	r.mu.Unlock()
+}
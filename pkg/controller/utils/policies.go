@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
+	"github.com/onosproject/device-provisioner/pkg/store/topo"
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-net-lib/pkg/realm"
+)
+
+// PolicyFollowerAspect marks the DeviceConfig aspect of an object as attached by a rollout to an object which
+// followed the assignment policies; the aspect is dropped once it matches the policies again, so that the object
+// resumes following them
+const PolicyFollowerAspect = "onos.provisioner.PolicyFollower"
+
+const (
+	policyWatchInitialBackoff = time.Second
+	policyWatchMaxBackoff     = 30 * time.Second
+)
+
+// PolicyLister lists the assignment policies
+type PolicyLister interface {
+	// List returns all assignment policies
+	List(ctx context.Context) ([]*configstore.AssignmentPolicy, error)
+}
+
+// NewPolicyCache returns a cache of the policies of the given store; the cache is filled and kept up to date
+// by WatchPolicies and falls back to the store while it is not watching the policies
+func NewPolicyCache(policyStore configstore.PolicyStore) *PolicyCache {
+	return &PolicyCache{store: policyStore}
+}
+
+// PolicyCache caches the assignment policies, so that reconciling an object does not list them from the store
+type PolicyCache struct {
+	store    configstore.PolicyStore
+	mu       sync.RWMutex
+	policies []*configstore.AssignmentPolicy
+	watching bool
+	lost     atomic.Bool
+}
+
+// Ready returns an error while the watch of the policies is lost and being re-established
+func (c *PolicyCache) Ready(ctx context.Context) error {
+	if c.lost.Load() {
+		return errors.NewUnavailable("assignment policy watch lost; re-establishing")
+	}
+	return nil
+}
+
+// List returns all assignment policies
+func (c *PolicyCache) List(ctx context.Context) ([]*configstore.AssignmentPolicy, error) {
+	c.mu.RLock()
+	if c.watching {
+		defer c.mu.RUnlock()
+		return c.policies, nil
+	}
+	c.mu.RUnlock()
+	return c.store.List(ctx)
+}
+
+// Reloads the policies from the store; the cache falls back to the store if they cannot be loaded
+func (c *PolicyCache) refresh(ctx context.Context) {
+	policies, err := c.store.List(ctx)
+	if err != nil {
+		log.Warnw("Unable to list assignment policies", "error", err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.policies, c.watching = policies, err == nil
+}
+
+// Stops serving the policies from the cache
+func (c *PolicyCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.policies, c.watching = nil, false
+}
+
+// GetDeviceConfig returns the desired configurations of the object; the explicit DeviceConfig aspect takes
+// precedence over the configurations assigned by the policies. Returns NotFound error if the object has neither.
+func GetDeviceConfig(ctx context.Context, policyLister PolicyLister, object *topoapi.Object) (*provisioner.DeviceConfig, error) {
+	deviceConfig := &provisioner.DeviceConfig{}
+	if err := object.GetAspect(deviceConfig); err == nil {
+		return deviceConfig, nil
+	}
+	policies, err := policyLister.List(ctx)
+	if err != nil {
+		log.Warnw("Unable to list assignment policies", "error", err)
+		return nil, err
+	}
+	if deviceConfig, ok := configstore.AssignDeviceConfig(policies, object.Labels); ok {
+		return deviceConfig, nil
+	}
+	return nil, errors.NewNotFound("no configuration is assigned to %s", object.ID)
+}
+
+// AssignedDeviceConfig returns the desired configurations of the object using the given policies, the same way
+// as GetDeviceConfig; returns false if the object has neither the DeviceConfig aspect nor a matching policy
+func AssignedDeviceConfig(object *topoapi.Object, policies []*configstore.AssignmentPolicy) (*provisioner.DeviceConfig, bool) {
+	deviceConfig := &provisioner.DeviceConfig{}
+	if err := object.GetAspect(deviceConfig); err == nil {
+		return deviceConfig, true
+	}
+	return configstore.AssignDeviceConfig(policies, object.Labels)
+}
+
+// SetDeviceConfig sets the DeviceConfig aspect of the object on behalf of a rollout; an object which follows
+// the policies keeps following them, i.e. the aspect is marked as attached by a rollout, or dropped if it
+// matches the configurations assigned by the policies, e.g. when a rollout is rolled back
+func SetDeviceConfig(object *topoapi.Object, deviceConfig *provisioner.DeviceConfig, policies []*configstore.AssignmentPolicy) error {
+	current := &provisioner.DeviceConfig{}
+	if err := object.GetAspect(current); err == nil && object.GetAspectBytes(PolicyFollowerAspect) == nil {
+		return object.SetAspect(deviceConfig)
+	}
+	if err := object.SetAspect(deviceConfig); err != nil {
+		return err
+	}
+	if err := object.SetAspectBytes(PolicyFollowerAspect, []byte("{}")); err != nil {
+		return err
+	}
+	FollowPolicies(object, policies)
+	return nil
+}
+
+// FollowPolicies drops the DeviceConfig aspect attached by a rollout to an object following the policies once
+// it matches the configurations assigned to the object by the policies; returns true if the aspect was dropped
+func FollowPolicies(object *topoapi.Object, policies []*configstore.AssignmentPolicy) bool {
+	if object.GetAspectBytes(PolicyFollowerAspect) == nil {
+		return false
+	}
+	deviceConfig := &provisioner.DeviceConfig{}
+	if err := object.GetAspect(deviceConfig); err != nil {
+		delete(object.Aspects, PolicyFollowerAspect)
+		return true
+	}
+	assigned, ok := configstore.AssignDeviceConfig(policies, object.Labels)
+	if !ok || assigned.PipelineConfigID != deviceConfig.PipelineConfigID || assigned.ChassisConfigID != deviceConfig.ChassisConfigID {
+		return false
+	}
+	delete(object.Aspects, proto.MessageName(deviceConfig))
+	delete(object.Aspects, PolicyFollowerAspect)
+	return true
+}
+
+// ResumeFollowingPolicies updates the object once its DeviceConfig aspect attached by a rollout matches
+// the policies, so that it follows the policies again; returns true if the object was updated
+func ResumeFollowingPolicies(ctx context.Context, topo topo.Store, policyLister PolicyLister, object *topoapi.Object) (bool, error) {
+	if object.GetAspectBytes(PolicyFollowerAspect) == nil {
+		return false, nil
+	}
+	policies, err := policyLister.List(ctx)
+	if err != nil {
+		log.Warnw("Unable to list assignment policies", "error", err)
+		return false, err
+	}
+	if !FollowPolicies(object, policies) {
+		return false, nil
+	}
+	log.Infow("Device configuration matches the policies again", "targetID", object.ID)
+	if err = topo.Update(ctx, object); err != nil {
+		if !errors.IsNotFound(err) {
+			log.Warnw("Unable to update object", "targetID", object.ID, "error", err)
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// WatchPolicies keeps the cache of the policies up to date and passes the IDs of all objects in the realm
+// to the given reconcile function whenever any assignment policy changes, so that the objects without
+// DeviceConfig aspect follow the policies. If the watch fails, it is re-established with backoff, and the
+// policies are reloaded and the realm swept again, so that the changes missed in the meantime are followed;
+// it returns once the context is done
+func WatchPolicies(ctx context.Context, topo topo.Store, policies *PolicyCache, realmOptions *realm.Options, reconcile func(topoapi.ID) error) {
+	defer policies.invalidate()
+	backoff := policyWatchInitialBackoff
+	for {
+		ch := make(chan *configstore.AssignmentPolicy)
+		if err := policies.store.Watch(ctx, ch); err != nil {
+			log.Warnw("Unable to watch assignment policies", "error", err)
+		} else {
+			// streams breaking right after being established do not reset the backoff
+			if watchPolicies(ctx, topo, policies, realmOptions, reconcile, ch) {
+				backoff = policyWatchInitialBackoff
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warnw("Assignment policy watch lost; re-establishing", "backoff", backoff)
+
+		// the policies are served from the store while the watch is lost
+		policies.invalidate()
+		policies.lost.Store(true)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+		if backoff *= 2; backoff > policyWatchMaxBackoff {
+			backoff = policyWatchMaxBackoff
+		}
+	}
+}
+
+// Follows the policy changes streamed to the channel until it is closed, starting with reloading the policies
+// and sweeping the realm if the watch was re-established; returns whether any change was received
+func watchPolicies(ctx context.Context, topo topo.Store, policies *PolicyCache, realmOptions *realm.Options, reconcile func(topoapi.ID) error, ch <-chan *configstore.AssignmentPolicy) bool {
+	policies.refresh(ctx)
+	if policies.lost.Swap(false) {
+		log.Infow("Assignment policy watch re-established")
+		sweepRealm(ctx, topo, realmOptions, reconcile)
+	}
+	received := false
+	for policy := range ch {
+		received = true
+		log.Infow("Assignment policy changed", "policyID", policy.ID)
+		policies.refresh(ctx)
+		sweepRealm(ctx, topo, realmOptions, reconcile)
+	}
+	return received
+}
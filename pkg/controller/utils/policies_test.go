@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/atomix/go-sdk/pkg/test"
+	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
+	"github.com/onosproject/device-provisioner/pkg/store/topo/topotest"
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDeviceConfig(t *testing.T) {
+	policies := []*configstore.AssignmentPolicy{{ID: "leaves", PipelineConfigID: "p4_leaf@1"}}
+
+	// A rollout moves a device following the policies off them, and rolling it back makes it follow them again
+	object := &topoapi.Object{ID: "leaf1"}
+	assert.NoError(t, SetDeviceConfig(object, &provisioner.DeviceConfig{PipelineConfigID: "p4_leaf@2"}, policies))
+	deviceConfig, _ := AssignedDeviceConfig(object, nil)
+	assert.Equal(t, provisioner.ConfigID("p4_leaf@2"), deviceConfig.PipelineConfigID)
+	assert.NotNil(t, object.GetAspectBytes(PolicyFollowerAspect))
+	assert.NoError(t, SetDeviceConfig(object, &provisioner.DeviceConfig{PipelineConfigID: "p4_leaf@1"}, policies))
+	assert.Error(t, object.GetAspect(&provisioner.DeviceConfig{}))
+	assert.Nil(t, object.GetAspectBytes(PolicyFollowerAspect))
+
+	// A device with its own DeviceConfig aspect keeps it
+	object = &topoapi.Object{ID: "leaf2"}
+	assert.NoError(t, object.SetAspect(&provisioner.DeviceConfig{PipelineConfigID: "p4_leaf@2"}))
+	assert.NoError(t, SetDeviceConfig(object, &provisioner.DeviceConfig{PipelineConfigID: "p4_leaf@1"}, policies))
+	assert.NoError(t, object.GetAspect(&provisioner.DeviceConfig{}))
+	assert.Nil(t, object.GetAspectBytes(PolicyFollowerAspect))
+}
+
+func TestResumeFollowingPolicies(t *testing.T) {
+	cluster := test.NewClient()
+	t.Cleanup(cluster.Close)
+	policyStore, err := configstore.NewAtomixPolicyStore(cluster)
+	assert.NoError(t, err)
+	ctx := context.TODO()
+	policy := &configstore.AssignmentPolicy{ID: "leaves", PipelineConfigID: "p4_leaf@1"}
+	assert.NoError(t, policyStore.Create(ctx, policy))
+
+	object := &topoapi.Object{ID: "leaf1", Type: topoapi.Object_ENTITY, Obj: &topoapi.Object_Entity{Entity: &topoapi.Entity{}}}
+	assert.NoError(t, SetDeviceConfig(object, &provisioner.DeviceConfig{PipelineConfigID: "p4_leaf@2"}, []*configstore.AssignmentPolicy{policy}))
+	topo := topotest.NewStore(object)
+	object, err = topo.Get(ctx, "leaf1")
+	assert.NoError(t, err)
+
+	// The device keeps the configuration of the rollout until the policy assigns the same
+	cache := NewPolicyCache(policyStore)
+	resumed, err := ResumeFollowingPolicies(ctx, topo, cache, object)
+	assert.NoError(t, err)
+	assert.False(t, resumed)
+
+	policy.PipelineConfigID = "p4_leaf@2"
+	assert.NoError(t, policyStore.Update(ctx, policy))
+	resumed, err = ResumeFollowingPolicies(ctx, topo, cache, object)
+	assert.NoError(t, err)
+	assert.True(t, resumed)
+	object, err = topo.Get(ctx, "leaf1")
+	assert.NoError(t, err)
+	assert.Error(t, object.GetAspect(&provisioner.DeviceConfig{}))
+	deviceConfig, err := GetDeviceConfig(ctx, cache, object)
+	assert.NoError(t, err)
+	assert.Equal(t, provisioner.ConfigID("p4_leaf@2"), deviceConfig.PipelineConfigID)
+	assert.NoError(t, policyStore.Close())
+}
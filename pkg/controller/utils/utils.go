@@ -10,17 +10,26 @@ import (
 	"github.com/gogo/protobuf/proto"
 	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
 	"github.com/onosproject/device-provisioner/pkg/store/topo"
+	"github.com/onosproject/device-provisioner/pkg/tracing"
 	"github.com/onosproject/onos-api/go/onos/provisioner"
 	topoapi "github.com/onosproject/onos-api/go/onos/topo"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/onosproject/onos-net-lib/pkg/realm"
+	"go.opentelemetry.io/otel/attribute"
+	"time"
 )
 
 var log = logging.GetLogger()
 
+// ConfigIDKey is the key of the span attribute holding the configuration ID
+const ConfigIDKey = attribute.Key("onos.config.id")
+
 // GetArtifacts Retrieves the required configuration artifacts from the store
-func GetArtifacts(ctx context.Context, configStore configstore.ConfigStore, configID provisioner.ConfigID, expectedNumber int) (configstore.Artifacts, error) {
+func GetArtifacts(ctx context.Context, configStore configstore.ConfigStore, configID provisioner.ConfigID, expectedNumber int) (artifacts configstore.Artifacts, err error) {
+	ctx, span := tracing.Start(ctx, "GetArtifacts", "", ConfigIDKey.String(string(configID)))
+	defer func() { tracing.End(span, err) }()
+
 	record, err := configStore.Get(ctx, configID)
 	if err != nil {
 		if !errors.IsNotFound(err) {
@@ -31,7 +40,7 @@ func GetArtifacts(ctx context.Context, configStore configstore.ConfigStore, conf
 	}
 
 	// ... and the associated artifacts
-	artifacts, err := configStore.GetArtifacts(ctx, record)
+	artifacts, err = configStore.GetArtifacts(ctx, record)
 	if err != nil {
 		log.Warnw("Unable to retrieve pipeline config artifacts", "configID", configID, "error", err)
 		return nil, err
@@ -45,13 +54,75 @@ func GetArtifacts(ctx context.Context, configStore configstore.ConfigStore, conf
 	return artifacts, nil
 }
 
-// RealmQueryFilter Returns filters for matching objects on realm label, entity type and with DeviceConfig aspect.
+// ResolveConfigID resolves the configuration reference to a reference of a specific configuration revision;
+// the reference is returned as is if the configuration does not exist
+func ResolveConfigID(ctx context.Context, configStore configstore.ConfigStore, ref provisioner.ConfigID) (provisioner.ConfigID, error) {
+	configID, err := configStore.Resolve(ctx, ref)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.Warnw("Unable to resolve configuration reference", "ref", ref, "error", err)
+			return "", err
+		}
+		return ref, nil
+	}
+	return configID, nil
+}
+
+// IsBareRef returns true if the configuration reference recorded by a configuration state is the bare ID of
+// the configuration of the given resolved reference; states recorded before configurations had revisions carry
+// the bare ID, which stands for the current revision, so that upgrading does not re-apply every configuration
+func IsBareRef(stateRef provisioner.ConfigID, resolved provisioner.ConfigID) bool {
+	configID, revision, err := configstore.ParseRef(resolved)
+	return err == nil && revision != 0 && stateRef == configID
+}
+
+// RealmQueryFilter Returns filters for matching objects on realm label, entity type and with StratumAgents aspect;
+// the DeviceConfig aspect is not required, as the configurations may be assigned to the objects by policies, so
+// the controllers skip the objects which no configuration is assigned to
 func RealmQueryFilter(realmOptions *realm.Options) *topoapi.Filters {
-	return realmOptions.QueryFilter("onos.provisioner.DeviceConfig", "onos.topo.StratumAgents")
+	return realmOptions.QueryFilter("onos.topo.StratumAgents")
+}
+
+// SweepRealm queries all objects in the realm right away and then periodically, passing their IDs to the given
+// reconcile function, so that devices which drifted from their desired configuration without any change in topo,
+// e.g. switches that rebooted, get reconciled; it returns once the context is done
+func SweepRealm(ctx context.Context, topo topo.Store, realmOptions *realm.Options, period time.Duration, reconcile func(topoapi.ID) error) {
+	sweepRealm(ctx, topo, realmOptions, reconcile)
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		sweepRealm(ctx, topo, realmOptions, reconcile)
+	}
+}
+
+// Passes the IDs of all objects in the realm to the given reconcile function
+func sweepRealm(ctx context.Context, topo topo.Store, realmOptions *realm.Options, reconcile func(topoapi.ID) error) {
+	log.Infow("Sweeping realm objects")
+	ch := make(chan *topoapi.Object)
+	if err := topo.Query(ctx, ch, RealmQueryFilter(realmOptions)); err != nil {
+		log.Warnw("Unable to query realm objects", "error", err)
+		return
+	}
+	for object := range ch {
+		if _, ok := object.Obj.(*topoapi.Object_Entity); !ok {
+			continue
+		}
+		if err := reconcile(object.ID); err != nil {
+			log.Warnw("Failed to reconcile object", "objectID", object.ID, "error", err)
+		}
+	}
 }
 
 // UpdateObjectAspect the topo object with the specified configuration aspect
-func UpdateObjectAspect(ctx context.Context, topo topo.Store, object *topoapi.Object, kind string, aspect proto.Message) error {
+func UpdateObjectAspect(ctx context.Context, topo topo.Store, object *topoapi.Object, kind string, aspect proto.Message) (err error) {
+	ctx, span := tracing.Start(ctx, "UpdateObjectAspect", object.ID, attribute.String("onos.config.kind", kind))
+	defer func() { tracing.End(span, err) }()
+
 	log.Infow("Updating  aspect", "kind", kind, "targetID", object.ID)
 	entity, err := topo.Get(ctx, object.ID)
 	if err != nil {
@@ -78,3 +149,143 @@ func UpdateObjectAspect(ctx context.Context, topo topo.Store, object *topoapi.Ob
 	}
 	return nil
 }
+
+// QueryConfigObjects returns all objects in the realm whose DeviceConfig, either explicit or assigned by
+// the policies, references any revision of the specified configuration
+func QueryConfigObjects(ctx context.Context, topo topo.Store, policyStore PolicyLister, realmOptions *realm.Options, configID provisioner.ConfigID) ([]*topoapi.Object, error) {
+	return queryConfigObjects(ctx, topo, policyStore, RealmQueryFilter(realmOptions), configID)
+}
+
+// QueryAllConfigObjects returns all objects in any realm whose DeviceConfig, either explicit or assigned by
+// the policies, references any revision of the specified configuration; the configurations are shared by
+// all realms, so this is what determines whether a configuration is still in use
+func QueryAllConfigObjects(ctx context.Context, topo topo.Store, policyStore PolicyLister, configID provisioner.ConfigID) ([]*topoapi.Object, error) {
+	filters := &topoapi.Filters{
+		ObjectTypes: []topoapi.Object_Type{topoapi.Object_ENTITY},
+		WithAspects: []string{"onos.topo.StratumAgents"},
+	}
+	return queryConfigObjects(ctx, topo, policyStore, filters, configID)
+}
+
+func queryConfigObjects(ctx context.Context, topo topo.Store, policyStore PolicyLister, filters *topoapi.Filters, configID provisioner.ConfigID) ([]*topoapi.Object, error) {
+	policies, err := policyStore.List(ctx)
+	if err != nil {
+		log.Warnw("Unable to list assignment policies", "error", err)
+		return nil, err
+	}
+	ch := make(chan *topoapi.Object)
+	if err = topo.Query(ctx, ch, filters); err != nil {
+		log.Warnw("Unable to query objects", "error", err)
+		return nil, err
+	}
+	objects := make([]*topoapi.Object, 0)
+	for object := range ch {
+		deviceConfig, ok := AssignedDeviceConfig(object, policies)
+		if !ok {
+			continue
+		}
+		if ReferencesConfig(deviceConfig.PipelineConfigID, configID) || ReferencesConfig(deviceConfig.ChassisConfigID, configID) {
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil
+}
+
+// Contains returns true if the given IDs include the specified one
+func Contains(ids []topoapi.ID, id topoapi.ID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ReferencesConfig returns true if the configuration reference refers to any revision of the specified configuration
+func ReferencesConfig(ref provisioner.ConfigID, configID provisioner.ConfigID) bool {
+	id, _, err := configstore.ParseRef(ref)
+	return err == nil && id == configID
+}
+
+// PinnedRevisions returns the function determining the revisions of a configuration referenced by number
+// by the DeviceConfig of the devices in any realm, by the assignment policies or by the rollouts
+func PinnedRevisions(topo topo.Store, policyStore configstore.PolicyStore, rolloutStore configstore.RolloutStore) configstore.PinnedRevisions {
+	return func(ctx context.Context, configID provisioner.ConfigID) (map[uint64]bool, error) {
+		pinned := make(map[uint64]bool)
+		pin := func(ref provisioner.ConfigID) {
+			if id, revision, err := configstore.ParseRef(ref); err == nil && id == configID && revision != 0 {
+				pinned[revision] = true
+			}
+		}
+		objects, err := QueryAllConfigObjects(ctx, topo, policyStore, configID)
+		if err != nil {
+			return nil, err
+		}
+		policies, err := policyStore.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range objects {
+			if deviceConfig, ok := AssignedDeviceConfig(object, policies); ok {
+				pin(deviceConfig.PipelineConfigID)
+				pin(deviceConfig.ChassisConfigID)
+			}
+		}
+		for _, policy := range policies {
+			pin(policy.PipelineConfigID)
+			pin(policy.ChassisConfigID)
+		}
+		rollouts, err := rolloutStore.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, rollout := range rollouts {
+			pin(rollout.FromConfigID)
+			pin(rollout.ToConfigID)
+		}
+		return pinned, nil
+	}
+}
+
+// RequeueConfigObjects moves the configuration state of all objects in any realm which follow the current
+// revision of the specified configuration back to PENDING, so that the controllers re-apply the configuration;
+// objects pinned to a specific revision are left alone
+func RequeueConfigObjects(ctx context.Context, topo topo.Store, policyStore configstore.PolicyStore, configID provisioner.ConfigID) error {
+	policies, err := policyStore.List(ctx)
+	if err != nil {
+		log.Warnw("Unable to list assignment policies", "error", err)
+		return err
+	}
+	objects, err := QueryAllConfigObjects(ctx, topo, policyStore, configID)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		deviceConfig, ok := AssignedDeviceConfig(object, policies)
+		if !ok {
+			continue
+		}
+		if deviceConfig.PipelineConfigID == configID {
+			pcState := &provisioner.PipelineConfigState{}
+			if err := object.GetAspect(pcState); err == nil {
+				pcState.Updated = time.Now()
+				pcState.Status.State = provisioner.ConfigStatus_PENDING
+				pcState.Cookie = 0
+				if err := UpdateObjectAspect(ctx, topo, object, "pipeline", pcState); err != nil {
+					return err
+				}
+			}
+		}
+		if deviceConfig.ChassisConfigID == configID {
+			ccState := &provisioner.ChassisConfigState{}
+			if err := object.GetAspect(ccState); err == nil {
+				ccState.Updated = time.Now()
+				ccState.Status.State = provisioner.ConfigStatus_PENDING
+				if err := UpdateObjectAspect(ctx, topo, object, "chassis", ccState); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
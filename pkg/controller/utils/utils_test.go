@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onosproject/device-provisioner/pkg/store/topo/topotest"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-net-lib/pkg/realm"
+	"github.com/stretchr/testify/assert"
+)
+
+func newEntity(t *testing.T, id topoapi.ID, pod string, stratum bool) *topoapi.Object {
+	object := &topoapi.Object{ID: id, Type: topoapi.Object_ENTITY, Obj: &topoapi.Object_Entity{Entity: &topoapi.Entity{}},
+		Labels: map[string]string{"pod": pod}}
+	if stratum {
+		assert.NoError(t, object.SetAspect(&topoapi.StratumAgents{}))
+	}
+	return object
+}
+
+func TestSweepRealm(t *testing.T) {
+	topo := topotest.NewStore(
+		newEntity(t, "leaf1", "pod-1", true),
+		newEntity(t, "leaf2", "pod-1", true),
+		newEntity(t, "leaf3", "pod-2", true),
+		newEntity(t, "host1", "pod-1", false))
+
+	var mu sync.Mutex
+	reconciled := make(map[topoapi.ID]int)
+	reconcile := func(id topoapi.ID) error {
+		mu.Lock()
+		defer mu.Unlock()
+		reconciled[id]++
+		return nil
+	}
+	count := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(reconciled)
+	}
+
+	// The realm devices are swept once right away rather than after the first period
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		SweepRealm(ctx, topo, &realm.Options{Label: "pod", Value: "pod-1"}, time.Hour, reconcile)
+	}()
+	assert.Eventually(t, func() bool { return count() == 2 }, time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[topoapi.ID]int{"leaf1": 1, "leaf2": 1}, reconciled)
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package health contains the health checking of the provisioner dependencies, exposed via the gRPC health
+// checking protocol and via HTTP liveness and readiness endpoints
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var log = logging.GetLogger()
+
+const (
+	// DefaultPeriod is the default period of the checks
+	DefaultPeriod = 10 * time.Second
+	// LivenessPath is the HTTP path of the liveness endpoint
+	LivenessPath = "/healthz"
+	// ReadinessPath is the HTTP path of the readiness endpoint
+	ReadinessPath = "/readyz"
+
+	checkTimeout = 5 * time.Second
+)
+
+// Check checks a dependency of the provisioner, returning an error if the dependency is not usable
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker runs the readiness checks periodically and reports the outcome of the last run; the checks are run
+// in the background rather than on request, so that probes are answered immediately and consistently via HTTP
+// and gRPC
+type Checker struct {
+	period   time.Duration
+	checks   []namedCheck
+	server   *health.Server
+	mu       sync.RWMutex
+	failures map[string]error
+	checked  bool
+	shutdown bool
+}
+
+// NewChecker returns a checker running the checks with the given period
+func NewChecker(period time.Duration) *Checker {
+	server := health.NewServer()
+	server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	return &Checker{period: period, server: server}
+}
+
+// Add adds a readiness check with the given name; checks must be added before the checker is started
+func (c *Checker) Add(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Start runs the checks right away and then periodically until the context is done
+func (c *Checker) Start(ctx context.Context) {
+	c.run(ctx)
+	go func() {
+		ticker := time.NewTicker(c.period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.run(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Runs all checks and records their outcome
+func (c *Checker) run(ctx context.Context) {
+	failures := make(map[string]error)
+	for _, check := range c.checks {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		if err := check.check(checkCtx); err != nil {
+			failures[check.name] = err
+		}
+		cancel()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for name, err := range failures {
+		if _, ok := c.failures[name]; !ok {
+			log.Warnf("Health check %s failed: %v", name, err)
+		}
+	}
+	for name := range c.failures {
+		if _, ok := failures[name]; !ok {
+			log.Infof("Health check %s recovered", name)
+		}
+	}
+	c.failures = failures
+	c.checked = true
+	if c.shutdown {
+		return
+	}
+	if len(failures) == 0 {
+		c.server.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	} else {
+		c.server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+// Ready returns the failures of the last run of the checks, by check name, and whether there were none;
+// the provisioner is not ready until the checks have run once, nor once it is shutting down
+func (c *Checker) Ready() (map[string]error, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.failures, c.checked && !c.shutdown && len(c.failures) == 0
+}
+
+// Shutdown reports the provisioner as not ready via both HTTP and gRPC from now on, so that it is taken out of
+// service before it stops
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shutdown = true
+	c.server.Shutdown()
+}
+
+// Returns whether the provisioner is shutting down
+func (c *Checker) isShutdown() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.shutdown
+}
+
+// Register registers the gRPC health checking service reflecting the readiness of the provisioner
+func (c *Checker) Register(r *grpc.Server) {
+	healthpb.RegisterHealthServer(r, c.server)
+}
+
+// RegisterHandlers registers the HTTP liveness and readiness endpoints; the liveness endpoint succeeds as long
+// as the provisioner serves HTTP, while the readiness endpoint fails with the failed checks listed
+func (c *Checker) RegisterHandlers(mux *http.ServeMux) {
+	mux.HandleFunc(LivenessPath, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc(ReadinessPath, func(w http.ResponseWriter, r *http.Request) {
+		failures, ready := c.Ready()
+		if ready {
+			_, _ = fmt.Fprintln(w, "ok")
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+		if c.isShutdown() {
+			_, _ = fmt.Fprintln(w, "shutting down")
+		} else if len(failures) == 0 {
+			_, _ = fmt.Fprintln(w, "not checked yet")
+		}
+		for _, check := range c.checks {
+			if err, ok := failures[check.name]; ok {
+				_, _ = fmt.Fprintf(w, "%s: %v\n", check.name, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func get(t *testing.T, url string) (int, string) {
+	response, err := http.Get(url)
+	if !assert.NoError(t, err) {
+		return 0, ""
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+	return response.StatusCode, string(body)
+}
+
+func servingStatus(t *testing.T, checker *Checker) healthpb.HealthCheckResponse_ServingStatus {
+	response, err := checker.server.Check(context.TODO(), &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+	return response.GetStatus()
+}
+
+func TestChecker(t *testing.T) {
+	var watching atomic.Bool
+	checker := NewChecker(time.Hour)
+	checker.Add("topo", func(ctx context.Context) error {
+		if !watching.Load() {
+			return errors.NewUnavailable("not watching topo")
+		}
+		return nil
+	})
+	checker.Add("atomix", func(ctx context.Context) error {
+		return nil
+	})
+
+	mux := http.NewServeMux()
+	checker.RegisterHandlers(mux)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	// not ready until checked
+	code, _ := get(t, server.URL+ReadinessPath)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker))
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	checker.Start(ctx)
+	code, body := get(t, server.URL+ReadinessPath)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.True(t, strings.HasPrefix(body, "topo: "))
+	assert.NotContains(t, body, "atomix")
+	code, _ = get(t, server.URL+LivenessPath)
+	assert.Equal(t, http.StatusOK, code)
+
+	watching.Store(true)
+	checker.run(ctx)
+	code, _ = get(t, server.URL+ReadinessPath)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, checker))
+
+	// shut down checkers report not serving regardless of the checks
+	checker.Shutdown()
+	checker.run(ctx)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, checker))
+	code, body = get(t, server.URL+ReadinessPath)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "shutting down\n", body)
+	code, _ = get(t, server.URL+LivenessPath)
+	assert.Equal(t, http.StatusOK, code)
+}
@@ -6,35 +6,74 @@
 package manager
 
 import (
+	"context"
 	"github.com/atomix/go-sdk/pkg/client"
+	"github.com/atomix/go-sdk/pkg/primitive"
 	"github.com/onosproject/device-provisioner/pkg/controller/chassis"
 	"github.com/onosproject/device-provisioner/pkg/controller/pipeline"
+	"github.com/onosproject/device-provisioner/pkg/controller/rollout"
 	"github.com/onosproject/device-provisioner/pkg/controller/target"
+	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	"github.com/onosproject/device-provisioner/pkg/health"
+	"github.com/onosproject/device-provisioner/pkg/metrics"
 	nb "github.com/onosproject/device-provisioner/pkg/northbound"
 	"github.com/onosproject/device-provisioner/pkg/store/configs"
 	"github.com/onosproject/device-provisioner/pkg/store/topo"
+	"github.com/onosproject/device-provisioner/pkg/tracing"
 	"github.com/onosproject/onos-lib-go/pkg/certs"
 	"github.com/onosproject/onos-lib-go/pkg/cli"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/onosproject/onos-lib-go/pkg/northbound"
 	"github.com/onosproject/onos-net-lib/pkg/p4rtclient"
 	"github.com/onosproject/onos-net-lib/pkg/realm"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"net/http"
+	"time"
 )
 
 var log = logging.GetLogger()
 
 // Config is a manager configuration
 type Config struct {
-	RealmOptions *realm.Options
-	TopoAddress  string
-	ArtifactDir  string
-	ServiceFlags *cli.ServiceEndpointFlags
+	RealmOptions    *realm.Options
+	TopoAddress     string
+	ArtifactBackend string
+	ArtifactDir     string
+	ObjectStore     configs.ObjectStoreConfig
+	ServiceFlags    *cli.ServiceEndpointFlags
+	ShutdownTimeout time.Duration
+	MetricsAddress  string
+	Tracing         tracing.Options
+	// AuditCapacity is the number of provisioning events retained by the audit log
+	AuditCapacity int
+	// RevisionRetention is the number of revisions retained for each configuration
+	RevisionRetention int
 }
 
+// DefaultShutdownTimeout is the default time allowed for the manager to stop
+const DefaultShutdownTimeout = 30 * time.Second
+
 // Manager single point of entry for the provisioner
 type Manager struct {
 	cli.Daemon
 	Config Config
+
+	atomixClient    *client.Client
+	configStore     configs.ConfigStore
+	rolloutStore    configs.RolloutStore
+	policyStore     configs.PolicyStore
+	auditStore      configs.AuditStore
+	batchStore      configs.BatchStore
+	targetManager   *target.Manager
+	pipelineManager *pipeline.Manager
+	chassisManager  *chassis.Manager
+	rolloutManager  *rollout.Manager
+	server          *northbound.Server
+	metricsServer   *http.Server
+	health          *health.Checker
+	tracerProvider  *sdktrace.TracerProvider
+	cancel          context.CancelFunc
 }
 
 // NewManager initializes the application manager
@@ -47,6 +86,12 @@ func NewManager(cfg Config) *Manager {
 func (m *Manager) Start() error {
 	log.Info("Starting Manager")
 
+	tracerProvider, err := tracing.NewProvider(context.Background(), m.Config.Tracing)
+	if err != nil {
+		return err
+	}
+	m.tracerProvider = tracerProvider
+
 	// Initialize and start the configuration provisioning controller
 	opts, err := certs.HandleCertPaths(m.Config.ServiceFlags.CAPath, m.Config.ServiceFlags.KeyPath, m.Config.ServiceFlags.CertPath, true)
 	if err != nil {
@@ -57,39 +102,251 @@ func (m *Manager) Start() error {
 		return err
 	}
 
-	configStore, err := configs.NewAtomixStore(client.NewClient(), m.Config.ArtifactDir)
+	m.atomixClient = client.NewClient()
+	artifactBackend, err := m.newArtifactBackend(m.atomixClient)
+	if err != nil {
+		return err
+	}
+	configStore, err := configs.NewAtomixStore(m.atomixClient, artifactBackend, m.Config.RevisionRetention)
+	if err != nil {
+		return err
+	}
+	m.configStore = configStore
+	rolloutStore, err := configs.NewAtomixRolloutStore(m.atomixClient)
+	if err != nil {
+		return err
+	}
+	m.rolloutStore = rolloutStore
+	policyStore, err := configs.NewAtomixPolicyStore(m.atomixClient)
+	if err != nil {
+		return err
+	}
+	m.policyStore = policyStore
+	capacity := m.Config.AuditCapacity
+	if capacity <= 0 {
+		capacity = configs.DefaultAuditCapacity
+	}
+	auditStore, err := configs.NewAtomixAuditStore(m.atomixClient, capacity)
+	if err != nil {
+		return err
+	}
+	m.auditStore = auditStore
+	batchStore, err := configs.NewAtomixBatchStore(m.atomixClient)
 	if err != nil {
 		return err
 	}
+	m.batchStore = batchStore
+	configStore.SetPinnedRevisions(utils.PinnedRevisions(topoStore, policyStore, rolloutStore))
 	conns := p4rtclient.NewConnManager()
 
-	targetManager := target.NewManager(topoStore, conns, m.Config.RealmOptions)
+	// Serve the metrics and the health endpoints
+	m.health = health.NewChecker(health.DefaultPeriod)
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	if err = metrics.WatchConnections(ctx, conns, m.Config.RealmOptions); err != nil {
+		return err
+	}
+	if err = metrics.RegisterCollectors(topoStore, configStore, m.Config.RealmOptions); err != nil {
+		return err
+	}
+	address := m.Config.MetricsAddress
+	if address == "" {
+		address = metrics.DefaultAddress
+	}
+	mux := http.NewServeMux()
+	m.health.RegisterHandlers(mux)
+	m.metricsServer = metrics.Serve(address, mux)
+
+	// Record the device state transitions in the audit log
+	if err = utils.WatchStateTransitions(ctx, topoStore, auditStore, m.Config.RealmOptions); err != nil {
+		return err
+	}
+
+	targetManager := target.NewManager(topoStore, conns, policyStore, m.Config.RealmOptions)
 	err = targetManager.Start()
 	if err != nil {
 		return err
 	}
+	m.targetManager = targetManager
 
-	pipelineManager := pipeline.NewManager(topoStore, conns, configStore, m.Config.RealmOptions)
+	pipelineManager := pipeline.NewManager(topoStore, conns, configStore, policyStore, auditStore, batchStore, m.Config.RealmOptions)
 
 	err = pipelineManager.Start()
 	if err != nil {
 		return err
 	}
+	m.pipelineManager = pipelineManager
 
-	chassisManager := chassis.NewManager(topoStore, configStore, m.Config.RealmOptions)
+	chassisManager := chassis.NewManager(topoStore, configStore, policyStore, auditStore, m.Config.RealmOptions)
 	err = chassisManager.Start()
 	if err != nil {
 		return err
 	}
+	m.chassisManager = chassisManager
+
+	rolloutManager := rollout.NewManager(topoStore, configStore, rolloutStore, policyStore, m.Config.RealmOptions)
+	err = rolloutManager.Start()
+	if err != nil {
+		return err
+	}
+	m.rolloutManager = rolloutManager
+
+	// The provisioner is ready while its controllers watch topo and the stores are usable
+	m.health.Add("topo", func(ctx context.Context) error {
+		if lost := topoStore.LostWatches(); lost > 0 {
+			return errors.NewUnavailable("%d topo watches lost; re-establishing", lost)
+		}
+		return nil
+	})
+	m.health.Add("target", targetManager.Ready)
+	m.health.Add("pipeline", pipelineManager.Ready)
+	m.health.Add("chassis", chassisManager.Ready)
+	m.health.Add("atomix", func(ctx context.Context) error {
+		_, err := policyStore.List(ctx)
+		return err
+	})
+	if checker, ok := artifactBackend.(configs.Checker); ok {
+		m.health.Add("artifacts", checker.Check)
+	}
+	m.health.Start(ctx)
 
 	// Start NB server
 	s := northbound.NewServer(cli.ServerConfigFromFlags(m.Config.ServiceFlags, northbound.SecurityConfig{}))
 	s.AddService(logging.Service{})
-	s.AddService(nb.NewService(configStore))
-	return s.StartInBackground()
+	s.AddService(m.health)
+	s.AddService(nb.NewService(configStore, rolloutStore, policyStore, auditStore, topoStore, m.Config.RealmOptions))
+	m.server = s
+	return m.startServer()
+}
+
+// Starts serving the NB API in the background, tracing the handling of each RPC
+func (m *Manager) startServer() error {
+	doneCh := make(chan error)
+	go func() {
+		err := m.server.Serve(func(started string) {
+			log.Info("Started NBI on ", started)
+			close(doneCh)
+		}, tracing.ServerOptions()...)
+		if err != nil {
+			doneCh <- err
+		}
+	}()
+	return <-doneCh
 }
 
-// Stop stops the manager
+// Creates the artifact backend selected by the configuration
+func (m *Manager) newArtifactBackend(client primitive.Client) (configs.ArtifactBackend, error) {
+	log.Infof("Using '%s' artifact backend", m.Config.ArtifactBackend)
+	switch m.Config.ArtifactBackend {
+	case configs.DirBackend, "":
+		return configs.NewDirBackend(m.Config.ArtifactDir)
+	case configs.ObjectStoreBackend:
+		return configs.NewObjectStoreBackend(m.Config.ObjectStore)
+	case configs.InlineBackend:
+		return configs.NewInlineBackend(client, configs.DefaultInlineMaxSize)
+	default:
+		return nil, errors.NewInvalid("unsupported artifact backend '%s'", m.Config.ArtifactBackend)
+	}
+}
+
+// Stop stops the manager in the reverse order of its start, all within the shutdown timeout: the provisioner
+// is reported as not serving, the NB server finishes the pending requests, the controllers drain their
+// in-flight reconciles, so that no device is left in the middle of a configuration push, the P4Runtime
+// connections are closed, releasing the mastership, and finally the stores are closed
 func (m *Manager) Stop() {
 	log.Info("Stopping Manager")
+	timeout := m.Config.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if m.health != nil {
+		m.health.Shutdown()
+	}
+	if m.server != nil {
+		m.stopServer(ctx)
+	}
+	// rollouts are stopped first, so that they do not move devices to configurations which are no longer applied
+	if m.rolloutManager != nil {
+		if err := m.rolloutManager.Stop(ctx); err != nil {
+			log.Warnf("Unable to drain rollout reconciles: %v", err)
+		}
+	}
+	if m.chassisManager != nil {
+		if err := m.chassisManager.Stop(ctx); err != nil {
+			log.Warnf("Unable to drain chassis config reconciles: %v", err)
+		}
+	}
+	if m.pipelineManager != nil {
+		if err := m.pipelineManager.Stop(ctx); err != nil {
+			log.Warnf("Unable to drain pipeline config reconciles: %v", err)
+		}
+	}
+	if m.targetManager != nil {
+		if err := m.targetManager.Stop(ctx); err != nil {
+			log.Warnf("Unable to drain target reconciles: %v", err)
+		}
+	}
+
+	if m.batchStore != nil {
+		if err := m.batchStore.Close(); err != nil {
+			log.Warnf("Unable to close batch store: %v", err)
+		}
+	}
+	if m.auditStore != nil {
+		if err := m.auditStore.Close(); err != nil {
+			log.Warnf("Unable to close audit store: %v", err)
+		}
+	}
+	if m.policyStore != nil {
+		if err := m.policyStore.Close(); err != nil {
+			log.Warnf("Unable to close policy store: %v", err)
+		}
+	}
+	if m.rolloutStore != nil {
+		if err := m.rolloutStore.Close(); err != nil {
+			log.Warnf("Unable to close rollout store: %v", err)
+		}
+	}
+	if m.configStore != nil {
+		if err := m.configStore.Close(); err != nil {
+			log.Warnf("Unable to close config store: %v", err)
+		}
+	}
+	if m.atomixClient != nil {
+		if err := m.atomixClient.Close(); err != nil {
+			log.Warnf("Unable to close Atomix client: %v", err)
+		}
+	}
+	if m.metricsServer != nil {
+		if err := m.metricsServer.Shutdown(ctx); err != nil {
+			log.Warnf("Unable to stop serving metrics: %v", err)
+		}
+	}
+	if m.tracerProvider != nil {
+		if err := m.tracerProvider.Shutdown(ctx); err != nil {
+			log.Warnf("Unable to flush traces: %v", err)
+		}
+	}
+	if m.cancel != nil {
+		m.cancel()
+	}
+	log.Info("Manager stopped")
+}
+
+// Stops the NB server gracefully, letting the pending requests complete, unless the context is done first
+func (m *Manager) stopServer(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		m.server.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.Warn("Pending NB requests did not complete; stopping NB server")
+		m.server.Stop()
+	}
 }
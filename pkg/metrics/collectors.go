@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	configstore "github.com/onosproject/device-provisioner/pkg/store/configs"
+	"github.com/onosproject/device-provisioner/pkg/store/topo"
+	provisionerapi "github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-net-lib/pkg/p4rtclient"
+	"github.com/onosproject/onos-net-lib/pkg/realm"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// collectTimeout bounds the queries issued while collecting the metrics on scrape
+	collectTimeout = 10 * time.Second
+	// noState is the state label value of the devices without a configuration state aspect
+	noState   = "NONE"
+	queueSize = 100
+)
+
+var (
+	devicesDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "devices"),
+		"Number of devices in the realm by configuration kind and state.",
+		[]string{"realm", "kind", "state"}, nil)
+
+	configsDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "configs"),
+		"Number of configurations in the inventory by kind.",
+		[]string{"kind"}, nil)
+
+	revisionsDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "config_revisions"),
+		"Number of revisions of all configurations in the inventory.", nil, nil)
+
+	artifactsDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "artifacts"),
+		"Number of distinct configuration artifacts kept by the artifact backend.", nil, nil)
+
+	artifactBytesDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "artifact_bytes"),
+		"Total size of the distinct configuration artifacts kept by the artifact backend.", nil, nil)
+
+	connections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "p4rt_connections",
+		Help:      "Number of established P4Runtime connections to the devices in the realm.",
+	}, []string{"realm"})
+)
+
+// deviceCollector counts the devices in the realm by the state of their configurations when scraped
+type deviceCollector struct {
+	topo         topo.Store
+	realmOptions *realm.Options
+}
+
+// NewDeviceCollector returns a collector of the number of devices in the realm by configuration kind and state
+func NewDeviceCollector(topo topo.Store, realmOptions *realm.Options) prometheus.Collector {
+	return &deviceCollector{topo: topo, realmOptions: realmOptions}
+}
+
+// Describe implements the prometheus.Collector interface
+func (c *deviceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- devicesDesc
+}
+
+// Collect implements the prometheus.Collector interface
+func (c *deviceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	objects := make(chan *topoapi.Object)
+	if err := c.topo.Query(ctx, objects, utils.RealmQueryFilter(c.realmOptions)); err != nil {
+		log.Warnw("Unable to query realm objects for metrics", "error", err)
+		ch <- prometheus.NewInvalidMetric(devicesDesc, err)
+		return
+	}
+
+	counts := map[string]map[string]int{
+		configstore.PipelineConfigKind: newStateCounts(),
+		configstore.ChassisConfigKind:  newStateCounts(),
+	}
+	for object := range objects {
+		pcState := &provisionerapi.PipelineConfigState{}
+		if err := object.GetAspect(pcState); err == nil {
+			counts[configstore.PipelineConfigKind][pcState.Status.State.String()]++
+		} else {
+			counts[configstore.PipelineConfigKind][noState]++
+		}
+		ccState := &provisionerapi.ChassisConfigState{}
+		if err := object.GetAspect(ccState); err == nil {
+			counts[configstore.ChassisConfigKind][ccState.Status.State.String()]++
+		} else {
+			counts[configstore.ChassisConfigKind][noState]++
+		}
+	}
+	for kind, states := range counts {
+		for state, count := range states {
+			ch <- prometheus.MustNewConstMetric(devicesDesc, prometheus.GaugeValue, float64(count), c.realmOptions.Value, kind, state)
+		}
+	}
+}
+
+// Returns the device counts of all states, so that the states without any devices are reported as well
+func newStateCounts() map[string]int {
+	counts := map[string]int{noState: 0}
+	for _, state := range provisionerapi.ConfigStatus_State_name {
+		counts[state] = 0
+	}
+	return counts
+}
+
+// inventoryCollector reports the size of the configuration inventory when scraped
+type inventoryCollector struct {
+	configStore configstore.ConfigStore
+}
+
+// NewInventoryCollector returns a collector of the size of the configuration inventory
+func NewInventoryCollector(configStore configstore.ConfigStore) prometheus.Collector {
+	return &inventoryCollector{configStore: configStore}
+}
+
+// Describe implements the prometheus.Collector interface
+func (c *inventoryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- configsDesc
+	ch <- revisionsDesc
+	ch <- artifactsDesc
+	ch <- artifactBytesDesc
+}
+
+// Collect implements the prometheus.Collector interface
+func (c *inventoryCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	usage, err := c.configStore.Usage(ctx)
+	if err != nil {
+		log.Warnw("Unable to get configuration inventory usage for metrics", "error", err)
+		ch <- prometheus.NewInvalidMetric(configsDesc, err)
+		return
+	}
+	for kind, count := range usage.Configs {
+		ch <- prometheus.MustNewConstMetric(configsDesc, prometheus.GaugeValue, float64(count), kind)
+	}
+	ch <- prometheus.MustNewConstMetric(revisionsDesc, prometheus.GaugeValue, float64(usage.Revisions))
+	ch <- prometheus.MustNewConstMetric(artifactsDesc, prometheus.GaugeValue, float64(usage.Artifacts))
+	ch <- prometheus.MustNewConstMetric(artifactBytesDesc, prometheus.GaugeValue, float64(usage.ArtifactBytes))
+}
+
+// WatchConnections keeps the P4Runtime connection count of the realm up to date with the connections established
+// by the given connection manager until the context is done
+func WatchConnections(ctx context.Context, conns p4rtclient.ConnManager, realmOptions *realm.Options) error {
+	ch := make(chan p4rtclient.Conn, queueSize)
+	if err := conns.Watch(ctx, ch); err != nil {
+		return err
+	}
+	gauge := connections.WithLabelValues(realmOptions.Value)
+	gauge.Set(0)
+	go func() {
+		// the connection manager notifies both the added and the removed connections
+		established := make(map[p4rtclient.ConnID]bool)
+		for conn := range ch {
+			if _, ok := conns.Get(ctx, conn.ID()); ok {
+				established[conn.ID()] = true
+			} else {
+				delete(established, conn.ID())
+			}
+			gauge.Set(float64(len(established)))
+		}
+	}()
+	return nil
+}
+
+// RegisterCollectors registers the collectors of the metrics of the devices in the realm, of the topo watches
+// and of the configuration inventory, which are computed when scraped
+func RegisterCollectors(topo topo.Store, configStore configstore.ConfigStore, realmOptions *realm.Options) error {
+	if err := prometheus.Register(NewDeviceCollector(topo, realmOptions)); err != nil {
+		return err
+	}
+	lostWatches := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "topo_watches_lost",
+		Help:      "Number of topo watches whose stream broke and which are being re-established.",
+	}, func() float64 {
+		return float64(topo.LostWatches())
+	})
+	if err := prometheus.Register(lostWatches); err != nil {
+		return err
+	}
+	return prometheus.Register(NewInventoryCollector(configStore))
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics contains the Prometheus metrics of the provisioner and the endpoint exposing them
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	"github.com/onosproject/onos-lib-go/pkg/controller/v2"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	p4api "github.com/p4lang/p4runtime/go/p4/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var log = logging.GetLogger()
+
+const namespace = "device_provisioner"
+
+// DefaultAddress is the default address on which the metrics are served
+const DefaultAddress = ":7070"
+
+// Names of the controllers whose reconciles are measured
+const (
+	TargetController   = "target"
+	PipelineController = "pipeline"
+	ChassisController  = "chassis"
+	RolloutController  = "rollout"
+)
+
+var (
+	reconciles = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconciles_total",
+		Help:      "Number of reconciles by controller and result.",
+	}, []string{"controller", "result"})
+
+	reconcileDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "reconcile_duration_seconds",
+		Help:      "Duration of reconciles by controller.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
+	}, []string{"controller"})
+
+	setPipelineConfigDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "p4rt_set_pipeline_config_duration_seconds",
+		Help:      "Duration of P4Runtime SetForwardingPipelineConfig calls by action and gRPC status code.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{"action", "code"})
+
+	gnmiSetDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "gnmi_set_duration_seconds",
+		Help:      "Duration of gNMI Set calls applying chassis configs by gRPC status code.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{"code"})
+
+	gnmiGetDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "gnmi_get_duration_seconds",
+		Help:      "Duration of gNMI Get calls checking chassis configs for drift by gRPC status code.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{"code"})
+)
+
+// Reconciler returns a reconciler which measures the reconciles of the given controller by the given reconciler
+func Reconciler[I controller.ID](name string, reconciler controller.Reconciler[I]) controller.Reconciler[I] {
+	return func(ctx context.Context, request controller.Request[I]) controller.Directive[I] {
+		start := time.Now()
+		directive := reconciler(ctx, request)
+		reconcileDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+		reconciles.WithLabelValues(name, result(directive)).Inc()
+		return directive
+	}
+}
+
+// Returns the result of a reconcile as given by its directive
+func result[I controller.ID](directive controller.Directive[I]) string {
+	switch directive.(type) {
+	case *controller.Ack[I], *controller.Requeue[I]:
+		return "success"
+	case *controller.Fail[I]:
+		return "failure"
+	default:
+		return "retry"
+	}
+}
+
+// ObserveSetPipelineConfig records a SetForwardingPipelineConfig call with the given action started at the given
+// time, which resulted in the given error
+func ObserveSetPipelineConfig(action p4api.SetForwardingPipelineConfigRequest_Action, start time.Time, err error) {
+	setPipelineConfigDuration.WithLabelValues(action.String(), utils.ErrorCode(err).String()).Observe(time.Since(start).Seconds())
+}
+
+// ObserveGNMISet records a gNMI Set call started at the given time, which resulted in the given error
+func ObserveGNMISet(start time.Time, err error) {
+	gnmiSetDuration.WithLabelValues(utils.ErrorCode(err).String()).Observe(time.Since(start).Seconds())
+}
+
+// ObserveGNMIGet records a gNMI Get call started at the given time, which resulted in the given error
+func ObserveGNMIGet(start time.Time, err error) {
+	gnmiGetDuration.WithLabelValues(utils.ErrorCode(err).String()).Observe(time.Since(start).Seconds())
+}
+
+// Serve serves the metrics of all registered collectors on the given address in the background, along with
+// the other handlers of the given mux
+func Serve(address string, mux *http.ServeMux) *http.Server {
+	mux.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{Addr: address, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		log.Infof("Serving metrics on %s", address)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Errorf("Unable to serve metrics: %v", err)
+		}
+	}()
+	return server
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+
+	dpapi "github.com/onosproject/device-provisioner/api/deviceprovisioner"
+	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	"github.com/onosproject/device-provisioner/pkg/store/configs"
+	api "github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ListEvents streams the retained provisioning events selected by the filter in the order in which they occurred
+func (s *Server) ListEvents(request *dpapi.ListEventsRequest, server dpapi.DeviceProvisionerService_ListEventsServer) error {
+	log.Infof("Received list events request: %+v", request)
+	events, err := s.audit.List(server.Context(), newAuditFilter(request.Filter))
+	if err != nil {
+		log.Warnf("Failed listing events: %v", err)
+		return errors.Status(err).Err()
+	}
+	for _, event := range events {
+		if err = server.Send(&dpapi.ListEventsResponse{Event: eventToAPI(event)}); err != nil {
+			log.Warnf("Unable to send event %d: %v", event.Index, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// WatchEvents streams the provisioning events selected by the filter as they occur, starting with the retained
+// events
+func (s *Server) WatchEvents(request *dpapi.WatchEventsRequest, server dpapi.DeviceProvisionerService_WatchEventsServer) error {
+	log.Infof("Received watch events request: %+v", request)
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
+	ch := make(chan *configs.AuditEvent)
+	if err := s.audit.Watch(ctx, newAuditFilter(request.Filter), ch); err != nil {
+		log.Warnf("Failed watching events: %v", err)
+		return errors.Status(err).Err()
+	}
+	for event := range ch {
+		if err := server.Send(&dpapi.WatchEventsResponse{Event: eventToAPI(event)}); err != nil {
+			log.Warnf("Unable to send event %d: %v", event.Index, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Records the change of the configuration by the NB client in the audit log
+func (s *Server) recordConfigEvent(ctx context.Context, eventType configs.AuditEventType, configID api.ConfigID, kind string) {
+	utils.RecordEvent(ctx, s.audit, &configs.AuditEvent{
+		Type:     eventType,
+		Actor:    actor(ctx),
+		ConfigID: configID,
+		Kind:     kind,
+	})
+}
+
+// Returns the identity of the NB client: the common name of its TLS client certificate, if any, or its address
+func actor(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+		return tlsInfo.State.PeerCertificates[0].Subject.CommonName
+	}
+	return p.Addr.String()
+}
+
+func newAuditFilter(filter *dpapi.EventFilter) configs.AuditFilter {
+	if filter == nil {
+		return configs.AuditFilter{}
+	}
+	return configs.AuditFilter{
+		TargetID: topoapi.ID(filter.TargetId),
+		ConfigID: api.ConfigID(filter.ConfigId),
+		Start:    fromTimestamp(filter.Start),
+		End:      fromTimestamp(filter.End),
+	}
+}
+
+func eventToAPI(event *configs.AuditEvent) *dpapi.Event {
+	return &dpapi.Event{
+		Index:    event.Index,
+		Type:     dpapi.Event_Type(dpapi.Event_Type_value[string(event.Type)]),
+		Time:     timestamp(event.Time),
+		Actor:    event.Actor,
+		TargetId: string(event.TargetID),
+		ConfigId: string(event.ConfigID),
+		Kind:     event.Kind,
+		State:    event.State,
+		Message:  event.Message,
+	}
+}
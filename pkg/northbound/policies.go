@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+
+	dpapi "github.com/onosproject/device-provisioner/api/deviceprovisioner"
+	"github.com/onosproject/device-provisioner/pkg/store/configs"
+	api "github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// CreatePolicy adds a policy assigning configurations to the devices with the given labels which have no explicit
+// DeviceConfig aspect
+func (s *Server) CreatePolicy(ctx context.Context, request *dpapi.CreatePolicyRequest) (*dpapi.CreatePolicyResponse, error) {
+	log.Infof("Received create policy request: %+v", request)
+	if request.Policy == nil {
+		return nil, errors.Status(errors.NewInvalid("policy cannot be empty")).Err()
+	}
+	policy := newPolicy(request.Policy)
+	if err := s.validatePolicy(ctx, policy); err != nil {
+		log.Warnf("Rejecting invalid policy %s: %v", policy.ID, err)
+		return nil, errors.Status(err).Err()
+	}
+	if err := s.policies.Create(ctx, policy); err != nil {
+		log.Warnf("Failed creating policy %s: %v", policy.ID, err)
+		return nil, errors.Status(err).Err()
+	}
+	return &dpapi.CreatePolicyResponse{Policy: policyToAPI(policy)}, nil
+}
+
+// UpdatePolicy updates the policy, provided it was not updated since it was read
+func (s *Server) UpdatePolicy(ctx context.Context, request *dpapi.UpdatePolicyRequest) (*dpapi.UpdatePolicyResponse, error) {
+	log.Infof("Received update policy request: %+v", request)
+	if request.Policy == nil {
+		return nil, errors.Status(errors.NewInvalid("policy cannot be empty")).Err()
+	}
+	policy := newPolicy(request.Policy)
+	if err := s.validatePolicy(ctx, policy); err != nil {
+		log.Warnf("Rejecting invalid policy %s: %v", policy.ID, err)
+		return nil, errors.Status(err).Err()
+	}
+	existing, err := s.policies.Get(ctx, policy.ID)
+	if err != nil {
+		log.Warnf("Failed retrieving policy %s: %v", policy.ID, err)
+		return nil, errors.Status(err).Err()
+	}
+	policy.Created = existing.Created
+	if err = s.policies.Update(ctx, policy); err != nil {
+		log.Warnf("Failed updating policy %s: %v", policy.ID, err)
+		return nil, errors.Status(err).Err()
+	}
+	return &dpapi.UpdatePolicyResponse{Policy: policyToAPI(policy)}, nil
+}
+
+// GetPolicy returns the specified policy
+func (s *Server) GetPolicy(ctx context.Context, request *dpapi.GetPolicyRequest) (*dpapi.GetPolicyResponse, error) {
+	log.Infof("Received get policy request: %+v", request)
+	policy, err := s.policies.Get(ctx, request.Id)
+	if err != nil {
+		log.Warnf("Failed retrieving policy %s: %v", request.Id, err)
+		return nil, errors.Status(err).Err()
+	}
+	return &dpapi.GetPolicyResponse{Policy: policyToAPI(policy)}, nil
+}
+
+// ListPolicies returns all policies
+func (s *Server) ListPolicies(ctx context.Context, request *dpapi.ListPoliciesRequest) (*dpapi.ListPoliciesResponse, error) {
+	log.Infof("Received list policies request: %+v", request)
+	policies, err := s.policies.List(ctx)
+	if err != nil {
+		log.Warnf("Failed listing policies: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+	response := &dpapi.ListPoliciesResponse{Policies: make([]*dpapi.Policy, 0, len(policies))}
+	for _, policy := range policies {
+		response.Policies = append(response.Policies, policyToAPI(policy))
+	}
+	return response, nil
+}
+
+// DeletePolicy removes the specified policy; the devices it assigned configurations to keep their configurations
+// until another policy or an explicit DeviceConfig aspect assigns them other ones
+func (s *Server) DeletePolicy(ctx context.Context, request *dpapi.DeletePolicyRequest) (*dpapi.DeletePolicyResponse, error) {
+	log.Infof("Received delete policy request: %+v", request)
+	if err := s.policies.Delete(ctx, request.Id); err != nil {
+		log.Warnf("Failed deleting policy %s: %v", request.Id, err)
+		return nil, errors.Status(err).Err()
+	}
+	return &dpapi.DeletePolicyResponse{}, nil
+}
+
+// Checks that the policy assigns existing configurations of the proper kinds
+func (s *Server) validatePolicy(ctx context.Context, policy *configs.AssignmentPolicy) error {
+	if policy.PipelineConfigID == "" && policy.ChassisConfigID == "" {
+		return errors.NewInvalid("policy must assign a pipeline or chassis configuration")
+	}
+	for kind, ref := range map[string]api.ConfigID{
+		configs.PipelineConfigKind: policy.PipelineConfigID,
+		configs.ChassisConfigKind:  policy.ChassisConfigID,
+	} {
+		if ref == "" {
+			continue
+		}
+		record, err := s.configStore.Get(ctx, ref)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return errors.NewInvalid("configuration %s not found", ref)
+			}
+			return err
+		}
+		if record.Kind != kind {
+			return errors.NewInvalid("configuration %s is not a %s configuration", ref, kind)
+		}
+	}
+	return nil
+}
+
+// Returns the policy to be created or updated as requested; the policy times are maintained by the store
+func newPolicy(policy *dpapi.Policy) *configs.AssignmentPolicy {
+	return &configs.AssignmentPolicy{
+		ID:               policy.Id,
+		Selector:         policy.Selector,
+		PipelineConfigID: api.ConfigID(policy.PipelineConfigId),
+		ChassisConfigID:  api.ConfigID(policy.ChassisConfigId),
+		Priority:         policy.Priority,
+		Version:          policy.Version,
+	}
+}
+
+func policyToAPI(policy *configs.AssignmentPolicy) *dpapi.Policy {
+	return &dpapi.Policy{
+		Id:               policy.ID,
+		Selector:         policy.Selector,
+		PipelineConfigId: string(policy.PipelineConfigID),
+		ChassisConfigId:  string(policy.ChassisConfigID),
+		Priority:         policy.Priority,
+		Created:          timestamp(policy.Created),
+		Updated:          timestamp(policy.Updated),
+		Version:          policy.Version,
+	}
+}
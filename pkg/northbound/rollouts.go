@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+
+	dpapi "github.com/onosproject/device-provisioner/api/deviceprovisioner"
+	"github.com/onosproject/device-provisioner/pkg/store/configs"
+	api "github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// CreateRollout starts a rollout moving the selected devices from one configuration to another in waves
+func (s *Server) CreateRollout(ctx context.Context, request *dpapi.CreateRolloutRequest) (*dpapi.CreateRolloutResponse, error) {
+	log.Infof("Received create rollout request: %+v", request)
+	if request.Rollout == nil {
+		return nil, errors.Status(errors.NewInvalid("rollout cannot be empty")).Err()
+	}
+	rollout := newRollout(request.Rollout)
+	rollout.Realm = s.realmOptions.Value
+	if err := s.validateRollout(ctx, rollout); err != nil {
+		log.Warnf("Rejecting invalid rollout %s: %v", rollout.ID, err)
+		return nil, errors.Status(err).Err()
+	}
+	if err := s.rollouts.Create(ctx, rollout); err != nil {
+		log.Warnf("Failed creating rollout %s: %v", rollout.ID, err)
+		return nil, errors.Status(err).Err()
+	}
+	return &dpapi.CreateRolloutResponse{Rollout: rolloutToAPI(rollout)}, nil
+}
+
+// GetRollout returns the specified rollout of the realm
+func (s *Server) GetRollout(ctx context.Context, request *dpapi.GetRolloutRequest) (*dpapi.GetRolloutResponse, error) {
+	log.Infof("Received get rollout request: %+v", request)
+	rollout, err := s.getRollout(ctx, request.Id)
+	if err != nil {
+		log.Warnf("Failed retrieving rollout %s: %v", request.Id, err)
+		return nil, errors.Status(err).Err()
+	}
+	return &dpapi.GetRolloutResponse{Rollout: rolloutToAPI(rollout)}, nil
+}
+
+// ListRollouts returns all rollouts of the realm
+func (s *Server) ListRollouts(ctx context.Context, request *dpapi.ListRolloutsRequest) (*dpapi.ListRolloutsResponse, error) {
+	log.Infof("Received list rollouts request: %+v", request)
+	rollouts, err := s.rollouts.List(ctx)
+	if err != nil {
+		log.Warnf("Failed listing rollouts: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+	response := &dpapi.ListRolloutsResponse{Rollouts: make([]*dpapi.Rollout, 0, len(rollouts))}
+	for _, rollout := range rollouts {
+		if rollout.Realm == s.realmOptions.Value {
+			response.Rollouts = append(response.Rollouts, rolloutToAPI(rollout))
+		}
+	}
+	return response, nil
+}
+
+// AbortRollout halts the specified rollout and moves its devices back to the original configuration
+func (s *Server) AbortRollout(ctx context.Context, request *dpapi.AbortRolloutRequest) (*dpapi.AbortRolloutResponse, error) {
+	log.Infof("Received abort rollout request: %+v", request)
+	rollout, err := s.getRollout(ctx, request.Id)
+	if err != nil {
+		log.Warnf("Failed retrieving rollout %s: %v", request.Id, err)
+		return nil, errors.Status(err).Err()
+	}
+	if rollout.State.Done() {
+		return nil, errors.Status(errors.NewConflict("rollout %s is already %s", request.Id, rollout.State)).Err()
+	}
+	rollout.State = configs.RolloutRollingBack
+	rollout.Message = "aborted"
+	if err = s.rollouts.Update(ctx, rollout); err != nil {
+		log.Warnf("Failed aborting rollout %s: %v", request.Id, err)
+		return nil, errors.Status(err).Err()
+	}
+	return &dpapi.AbortRolloutResponse{Rollout: rolloutToAPI(rollout)}, nil
+}
+
+// DeleteRollout removes the specified rollout, provided it reached a final state
+func (s *Server) DeleteRollout(ctx context.Context, request *dpapi.DeleteRolloutRequest) (*dpapi.DeleteRolloutResponse, error) {
+	log.Infof("Received delete rollout request: %+v", request)
+	rollout, err := s.getRollout(ctx, request.Id)
+	if err != nil {
+		log.Warnf("Failed retrieving rollout %s: %v", request.Id, err)
+		return nil, errors.Status(err).Err()
+	}
+	if !rollout.State.Done() {
+		return nil, errors.Status(errors.NewConflict("rollout %s is still %s", request.Id, rollout.State)).Err()
+	}
+	if err = s.rollouts.Delete(ctx, request.Id); err != nil {
+		log.Warnf("Failed deleting rollout %s: %v", request.Id, err)
+		return nil, errors.Status(err).Err()
+	}
+	return &dpapi.DeleteRolloutResponse{}, nil
+}
+
+// Returns the specified rollout, provided it was created in the realm; the rollouts of other realms are not found
+func (s *Server) getRollout(ctx context.Context, id string) (*configs.Rollout, error) {
+	rollout, err := s.rollouts.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rollout.Realm != s.realmOptions.Value {
+		return nil, errors.NewNotFound("rollout %s not found", id)
+	}
+	return rollout, nil
+}
+
+// Checks that the rollout moves devices between two existing configurations of its kind
+func (s *Server) validateRollout(ctx context.Context, rollout *configs.Rollout) error {
+	if rollout.Kind != configs.PipelineConfigKind && rollout.Kind != configs.ChassisConfigKind {
+		return errors.NewInvalid("unsupported rollout kind '%s'", rollout.Kind)
+	}
+	if rollout.BatchSize == 0 {
+		return errors.NewInvalid("rollout batch size must be positive")
+	}
+	if rollout.FromConfigID == rollout.ToConfigID {
+		return errors.NewInvalid("rollout must move devices to another configuration")
+	}
+	for _, ref := range []api.ConfigID{rollout.FromConfigID, rollout.ToConfigID} {
+		record, err := s.configStore.Get(ctx, ref)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return errors.NewInvalid("configuration %s not found", ref)
+			}
+			return err
+		}
+		if record.Kind != rollout.Kind {
+			return errors.NewInvalid("configuration %s is not a %s configuration", ref, rollout.Kind)
+		}
+	}
+	return nil
+}
+
+// Returns the rollout to be created as requested; the progress of the rollout is tracked by the provisioner
+// and is not taken from the request
+func newRollout(rollout *dpapi.Rollout) *configs.Rollout {
+	return &configs.Rollout{
+		ID:               rollout.Id,
+		Kind:             rollout.Kind,
+		Selector:         rollout.Selector,
+		FromConfigID:     api.ConfigID(rollout.FromConfigId),
+		ToConfigID:       api.ConfigID(rollout.ToConfigId),
+		BatchSize:        rollout.BatchSize,
+		SoakTime:         rollout.SoakTime.AsDuration(),
+		FailureThreshold: rollout.FailureThreshold,
+	}
+}
+
+func rolloutToAPI(rollout *configs.Rollout) *dpapi.Rollout {
+	return &dpapi.Rollout{
+		Id:               rollout.ID,
+		Kind:             rollout.Kind,
+		Selector:         rollout.Selector,
+		FromConfigId:     string(rollout.FromConfigID),
+		ToConfigId:       string(rollout.ToConfigID),
+		BatchSize:        rollout.BatchSize,
+		SoakTime:         durationpb.New(rollout.SoakTime),
+		FailureThreshold: rollout.FailureThreshold,
+		State:            dpapi.Rollout_State(dpapi.Rollout_State_value[string(rollout.State)]),
+		Devices:          ids(rollout.Devices),
+		Wave:             rollout.Wave,
+		WaveSettled:      timestamp(rollout.WaveSettled),
+		Failed:           ids(rollout.Failed),
+		Message:          rollout.Message,
+		Created:          timestamp(rollout.Created),
+		Updated:          timestamp(rollout.Updated),
+	}
+}
+
+func ids(objectIDs []topoapi.ID) []string {
+	result := make([]string, 0, len(objectIDs))
+	for _, id := range objectIDs {
+		result = append(result, string(id))
+	}
+	return result
+}
@@ -7,12 +7,21 @@ package northbound
 
 import (
 	"context"
+	dpapi "github.com/onosproject/device-provisioner/api/deviceprovisioner"
+	"github.com/onosproject/device-provisioner/pkg/controller/utils"
 	"github.com/onosproject/device-provisioner/pkg/store/configs"
+	"github.com/onosproject/device-provisioner/pkg/store/topo"
 	api "github.com/onosproject/onos-api/go/onos/provisioner"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/onosproject/onos-lib-go/pkg/northbound"
+	"github.com/onosproject/onos-net-lib/pkg/realm"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"time"
 )
 
 var log = logging.GetLogger()
@@ -20,50 +29,222 @@ var log = logging.GetLogger()
 // Service implements the device provisioner NB gRPC
 type Service struct {
 	northbound.Service
-	configStore configs.ConfigStore
+	configStore  configs.ConfigStore
+	rollouts     configs.RolloutStore
+	policies     configs.PolicyStore
+	audit        configs.AuditStore
+	topo         topo.Store
+	realmOptions *realm.Options
 }
 
 // NewService allocates a Service struct with the given parameters
-func NewService(configStore configs.ConfigStore) Service {
+func NewService(configStore configs.ConfigStore, rollouts configs.RolloutStore, policies configs.PolicyStore, audit configs.AuditStore, topo topo.Store, realmOptions *realm.Options) Service {
 	return Service{
-		configStore: configStore,
+		configStore:  configStore,
+		rollouts:     rollouts,
+		policies:     policies,
+		audit:        audit,
+		topo:         topo,
+		realmOptions: realmOptions,
 	}
 }
 
 // Register registers the server with grpc
 func (s Service) Register(r *grpc.Server) {
 	server := &Server{
-		configStore: s.configStore,
+		configStore:  s.configStore,
+		rollouts:     s.rollouts,
+		policies:     s.policies,
+		audit:        s.audit,
+		topo:         s.topo,
+		realmOptions: s.realmOptions,
 	}
 	api.RegisterProvisionerServiceServer(r, server)
+	dpapi.RegisterDeviceProvisionerServiceServer(r, server)
 	log.Debug("Device Provisioner API services registered")
 }
 
-// Server implements the grpc device provisioner service
+// Server implements the grpc device provisioner services
 type Server struct {
-	configStore configs.ConfigStore
+	dpapi.UnimplementedDeviceProvisionerServiceServer
+	configStore  configs.ConfigStore
+	rollouts     configs.RolloutStore
+	policies     configs.PolicyStore
+	audit        configs.AuditStore
+	topo         topo.Store
+	realmOptions *realm.Options
 }
 
-// Add registers new pipeline configuration
+// Add registers new pipeline configuration; pipeline and chassis configurations are validated first
 func (s *Server) Add(ctx context.Context, request *api.AddConfigRequest) (*api.AddConfigResponse, error) {
+	if request.Config == nil || request.Config.Record == nil {
+		log.Warnf("Rejecting add request without a configuration record: %+v", request)
+		return nil, errors.Status(errors.NewInvalid("Record or Artifacts cannot be empty")).Err()
+	}
 	log.Infof("Received add request for: %+v", request.Config.Record)
+	if err := configs.Validate(request.Config.Record.Kind, request.Config.Artifacts); err != nil {
+		log.Warnf("Rejecting invalid configuration %+v: %v", request.Config.Record, err)
+		return nil, errors.Status(err).Err()
+	}
 	if err := s.configStore.Add(ctx, request.Config.Record, request.Config.Artifacts); err != nil {
 		log.Warnf("Failed adding configuration %+v: %v", request.Config.Record, err)
 		return nil, errors.Status(err).Err()
 	}
+	s.recordConfigEvent(ctx, configs.ConfigAdded, request.Config.Record.ConfigID, request.Config.Record.Kind)
 	return &api.AddConfigResponse{}, nil
 }
 
-// Delete removes a pipeline configuration
+// UpdateConfig atomically replaces the artifacts of an existing configuration by adding a new current revision,
+// provided the configuration history is still at the given version (0 skips the check), and moves all devices
+// in any realm following the configuration back to PENDING so that the controllers re-apply it
+func (s *Server) UpdateConfig(ctx context.Context, request *dpapi.UpdateConfigRequest) (*dpapi.UpdateConfigResponse, error) {
+	log.Infof("Received update request for: %s", request.ConfigId)
+	configID := api.ConfigID(request.ConfigId)
+	record, err := s.configStore.Get(ctx, configID)
+	if err != nil {
+		log.Warnf("Failed updating configuration %s: %v", configID, err)
+		return nil, errors.Status(err).Err()
+	}
+	if err = configs.Validate(record.Kind, request.Artifacts); err != nil {
+		log.Warnf("Rejecting invalid configuration %s: %v", configID, err)
+		return nil, errors.Status(err).Err()
+	}
+	history, err := s.configStore.AddRevision(ctx, configID, request.Artifacts, request.Version)
+	if err != nil {
+		log.Warnf("Failed updating configuration %s: %v", configID, err)
+		return nil, revisionStatus(err).Err()
+	}
+	if err = s.configUpdated(ctx, record, history.Current); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &dpapi.UpdateConfigResponse{Revision: history.Current, Version: history.Version}, nil
+}
+
+// Records the new revision of the configuration and moves the devices following the configuration back to PENDING
+func (s *Server) configUpdated(ctx context.Context, record *api.ConfigRecord, revision uint64) error {
+	s.recordConfigEvent(ctx, configs.ConfigUpdated, configs.Ref(record.ConfigID, revision), record.Kind)
+	if err := utils.RequeueConfigObjects(ctx, s.topo, s.policies, record.ConfigID); err != nil {
+		log.Warnf("Failed requeueing devices for configuration %s: %v", record.ConfigID, err)
+		return err
+	}
+	return nil
+}
+
+// Delete deletes a configuration which is no longer referenced by any devices or policies
 func (s *Server) Delete(ctx context.Context, request *api.DeleteConfigRequest) (*api.DeleteConfigResponse, error) {
 	log.Infof("Received delete request: %+v", request)
-	if err := s.configStore.Delete(ctx, request.ConfigID); err != nil {
-		log.Warnf("Failed deleting configuration %s: %v", request.ConfigID, err)
-		return nil, errors.Status(err).Err()
+	if err := s.deleteConfig(ctx, request.ConfigID, false); err != nil {
+		return nil, err
 	}
 	return &api.DeleteConfigResponse{}, nil
 }
 
+// DeleteConfig deletes a configuration; unless forced, the configuration must not be referenced by any devices
+// in any realm or by any policies
+func (s *Server) DeleteConfig(ctx context.Context, request *dpapi.DeleteConfigRequest) (*dpapi.DeleteConfigResponse, error) {
+	log.Infof("Received delete config request: %+v", request)
+	if err := s.deleteConfig(ctx, api.ConfigID(request.ConfigId), request.Force); err != nil {
+		return nil, err
+	}
+	return &dpapi.DeleteConfigResponse{}, nil
+}
+
+// Deletes the configuration, refusing to delete a configuration still in use unless forced; the configurations
+// are shared by all realms, so the devices of all realms are checked
+func (s *Server) deleteConfig(ctx context.Context, configID api.ConfigID, force bool) error {
+	if !force {
+		objects, err := utils.QueryAllConfigObjects(ctx, s.topo, s.policies, configID)
+		if err != nil {
+			log.Warnf("Failed querying devices referencing configuration %s: %v", configID, err)
+			return errors.Status(err).Err()
+		}
+		policies, err := s.policies.List(ctx)
+		if err != nil {
+			log.Warnf("Failed listing policies referencing configuration %s: %v", configID, err)
+			return errors.Status(err).Err()
+		}
+		ids := make([]string, 0, len(objects))
+		for _, object := range objects {
+			ids = append(ids, string(object.ID))
+		}
+		for _, policy := range policies {
+			if utils.ReferencesConfig(policy.PipelineConfigID, configID) || utils.ReferencesConfig(policy.ChassisConfigID, configID) {
+				ids = append(ids, "policy "+policy.ID)
+			}
+		}
+		if len(ids) > 0 {
+			log.Warnf("Refusing to delete configuration %s referenced by %v", configID, ids)
+			return status.Errorf(codes.FailedPrecondition, "configuration %s is referenced by %s; use DeleteConfig with force to delete it anyway",
+				configID, strings.Join(ids, ", "))
+		}
+	}
+	record, err := s.configStore.Get(ctx, configID)
+	if err != nil {
+		log.Warnf("Failed deleting configuration %s: %v", configID, err)
+		return errors.Status(err).Err()
+	}
+	if err = s.configStore.Delete(ctx, configID); err != nil {
+		log.Warnf("Failed deleting configuration %s: %v", configID, err)
+		return errors.Status(err).Err()
+	}
+	s.recordConfigEvent(ctx, configs.ConfigDeleted, configID, record.Kind)
+	return nil
+}
+
+// SetApplyMode sets the mode in which a pipeline configuration is applied to the devices
+func (s *Server) SetApplyMode(ctx context.Context, request *dpapi.SetApplyModeRequest) (*dpapi.SetApplyModeResponse, error) {
+	log.Infof("Received set apply mode request: %+v", request)
+	if err := s.configStore.SetApplyMode(ctx, api.ConfigID(request.ConfigId), request.Mode.String()); err != nil {
+		log.Warnf("Failed setting apply mode of configuration %s: %v", request.ConfigId, err)
+		return nil, errors.Status(err).Err()
+	}
+	return &dpapi.SetApplyModeResponse{}, nil
+}
+
+// Rollback makes a prior revision the current revision of the configuration and moves the devices following
+// the configuration back to PENDING
+func (s *Server) Rollback(ctx context.Context, request *dpapi.RollbackRequest) (*dpapi.RollbackResponse, error) {
+	log.Infof("Received rollback request: %+v", request)
+	configID := api.ConfigID(request.ConfigId)
+	record, err := s.configStore.Get(ctx, configID)
+	if err != nil {
+		log.Warnf("Failed rolling back configuration %s: %v", configID, err)
+		return nil, errors.Status(err).Err()
+	}
+	if err = s.configStore.Rollback(ctx, configID, request.Revision); err != nil {
+		log.Warnf("Failed rolling back configuration %s: %v", configID, err)
+		return nil, errors.Status(err).Err()
+	}
+	if err = s.configUpdated(ctx, record, request.Revision); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &dpapi.RollbackResponse{}, nil
+}
+
+// GetHistory returns the retained revisions of the configuration and the version of its history
+func (s *Server) GetHistory(ctx context.Context, request *dpapi.GetHistoryRequest) (*dpapi.GetHistoryResponse, error) {
+	log.Infof("Received get history request: %+v", request)
+	history, err := s.configStore.GetHistory(ctx, api.ConfigID(request.ConfigId))
+	if err != nil {
+		log.Warnf("Failed retrieving history of configuration %s: %v", request.ConfigId, err)
+		return nil, errors.Status(err).Err()
+	}
+	response := &dpapi.GetHistoryResponse{
+		Revisions: make([]*dpapi.Revision, 0, len(history.Revisions)),
+		Current:   history.Current,
+		Version:   history.Version,
+	}
+	for _, revision := range history.Revisions {
+		response.Revisions = append(response.Revisions, &dpapi.Revision{
+			Number:  revision.Number,
+			Digests: revision.Digests,
+			Created: timestamp(revision.Created),
+			Sizes:   revision.Sizes,
+		})
+	}
+	return response, nil
+}
+
 // Get returns pipeline configuration based on a given ID
 func (s *Server) Get(ctx context.Context, request *api.GetConfigRequest) (*api.GetConfigResponse, error) {
 	log.Infof("Received get request: %+v", request)
@@ -77,7 +258,7 @@ func (s *Server) Get(ctx context.Context, request *api.GetConfigRequest) (*api.G
 		artifacts, err = s.configStore.GetArtifacts(ctx, record)
 		if err != nil {
 			log.Warnf("Failed retrieving artifacts for %s: %v", request.ConfigID, err)
-			return nil, errors.Status(err).Err()
+			return nil, artifactsStatus(err).Err()
 		}
 	}
 	return &api.GetConfigResponse{Config: &api.Config{Record: record, Artifacts: artifacts}}, nil
@@ -100,7 +281,7 @@ func (s *Server) List(request *api.ListConfigsRequest, server api.ProvisionerSer
 			artifacts, err = s.configStore.GetArtifacts(server.Context(), record)
 			if err != nil {
 				log.Warnf("Failed retrieving artifacts for %s: %v", record.ConfigID, err)
-				return err
+				return artifactsStatus(err).Err()
 			}
 		}
 		if err = server.Send(&api.ListConfigsResponse{Config: &api.Config{Record: record, Artifacts: artifacts}}); err != nil {
@@ -110,3 +291,64 @@ func (s *Server) List(request *api.ListConfigsRequest, server api.ProvisionerSer
 	}
 	return nil
 }
+
+// WatchConfigs streams the changes of the configurations of the requested kind, or of all kinds, as they occur,
+// optionally starting with the existing configurations
+func (s *Server) WatchConfigs(request *dpapi.WatchConfigsRequest, server dpapi.DeviceProvisionerService_WatchConfigsServer) error {
+	log.Infof("Received watch configs request: %+v", request)
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
+	ch := make(chan configs.Event)
+	if err := s.configStore.Watch(ctx, request.Kind, ch, request.Replay); err != nil {
+		log.Warnf("Failed watching configurations: %v", err)
+		return errors.Status(err).Err()
+	}
+	for event := range ch {
+		response := &dpapi.WatchConfigsResponse{
+			Type: dpapi.WatchConfigsResponse_Type(dpapi.WatchConfigsResponse_Type_value[event.Type.String()]),
+			Config: &dpapi.ConfigRecord{
+				ConfigId:  string(event.Record.ConfigID),
+				Kind:      event.Record.Kind,
+				Artifacts: event.Record.Artifacts,
+			},
+		}
+		if err := server.Send(response); err != nil {
+			log.Warnf("Unable to send event for %s: %v", event.Record.ConfigID, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns gRPC status for the given artifact retrieval error, reporting corrupted artifacts as data loss
+func artifactsStatus(err error) *status.Status {
+	if configs.IsDataLoss(err) {
+		return status.New(codes.DataLoss, err.Error())
+	}
+	return errors.Status(err)
+}
+
+// Returns gRPC status for the given error of adding a revision, reporting revisions based on a stale version
+// of the configuration history as aborted, so that the client re-reads the history before retrying
+func revisionStatus(err error) *status.Status {
+	if errors.IsConflict(err) {
+		return status.New(codes.Aborted, err.Error())
+	}
+	return artifactsStatus(err)
+}
+
+// Returns the protobuf timestamp of the given time; nil for the zero time
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// Returns the time of the given protobuf timestamp; the zero time if it is not set
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,387 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/atomix/go-sdk/pkg/test"
+	dpapi "github.com/onosproject/device-provisioner/api/deviceprovisioner"
+	"github.com/onosproject/device-provisioner/pkg/store/configs"
+	"github.com/onosproject/device-provisioner/pkg/store/topo/topotest"
+	api "github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-net-lib/pkg/realm"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testP4Info = `actions { preamble { id: 16777217 name: "ingress.drop" } }`
+
+const testChassisConfig = `description: "leaf switch"`
+
+// testRealm is the realm of the provisioner under test
+var testRealm = &realm.Options{Label: "pod", Value: "pod-1"}
+
+// testService serves the provisioner services over an in-memory connection
+type testService struct {
+	client      dpapi.DeviceProvisionerServiceClient
+	provisioner api.ProvisionerServiceClient
+	configStore configs.ConfigStore
+	rollouts    configs.RolloutStore
+	policies    configs.PolicyStore
+	topo        *topotest.Store
+}
+
+func newTestService(t *testing.T, objects ...*topoapi.Object) *testService {
+	cluster := test.NewClient()
+	t.Cleanup(cluster.Close)
+	backend, err := configs.NewDirBackend(t.TempDir())
+	assert.NoError(t, err)
+	configStore, err := configs.NewAtomixStore(cluster, backend, configs.DefaultRevisionRetention)
+	assert.NoError(t, err)
+	rollouts, err := configs.NewAtomixRolloutStore(cluster)
+	assert.NoError(t, err)
+	policies, err := configs.NewAtomixPolicyStore(cluster)
+	assert.NoError(t, err)
+	audit, err := configs.NewAtomixAuditStore(cluster, configs.DefaultAuditCapacity)
+	assert.NoError(t, err)
+	topo := topotest.NewStore(objects...)
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	NewService(configStore, rollouts, policies, audit, topo, testRealm).Register(server)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return &testService{client: dpapi.NewDeviceProvisionerServiceClient(conn), provisioner: api.NewProvisionerServiceClient(conn), configStore: configStore, rollouts: rollouts, policies: policies, topo: topo}
+}
+
+// Returns a device in the test realm with the given DeviceConfig aspect and chassis config state
+func newDevice(t *testing.T, id topoapi.ID, deviceConfig *api.DeviceConfig, ccState *api.ChassisConfigState) *topoapi.Object {
+	object := &topoapi.Object{ID: id, Type: topoapi.Object_ENTITY, Obj: &topoapi.Object_Entity{Entity: &topoapi.Entity{}},
+		Labels: map[string]string{testRealm.Label: testRealm.Value}}
+	assert.NoError(t, object.SetAspect(&topoapi.StratumAgents{}))
+	if deviceConfig != nil {
+		assert.NoError(t, object.SetAspect(deviceConfig))
+	}
+	if ccState != nil {
+		assert.NoError(t, object.SetAspect(ccState))
+	}
+	return object
+}
+
+func TestAddConfig(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.TODO()
+
+	// Requests without a configuration or record are rejected rather than failing the handler
+	_, err := s.provisioner.Add(ctx, &api.AddConfigRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.provisioner.Add(ctx, &api.AddConfigRequest{Config: &api.Config{Artifacts: map[string][]byte{api.ChassisType: []byte(testChassisConfig)}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.provisioner.Add(ctx, &api.AddConfigRequest{Config: &api.Config{Record: &api.ConfigRecord{ConfigID: "ch_leaf", Kind: configs.ChassisConfigKind},
+		Artifacts: map[string][]byte{api.ChassisType: []byte("description {")}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.provisioner.Add(ctx, &api.AddConfigRequest{Config: &api.Config{Record: &api.ConfigRecord{ConfigID: "ch_leaf", Kind: configs.ChassisConfigKind},
+		Artifacts: map[string][]byte{api.ChassisType: []byte(testChassisConfig)}}})
+	assert.NoError(t, err)
+	_, err = s.configStore.Get(ctx, "ch_leaf")
+	assert.NoError(t, err)
+}
+
+func TestUpdateConfig(t *testing.T) {
+	other := newDevice(t, "leaf2", &api.DeviceConfig{ChassisConfigID: "ch_leaf"},
+		&api.ChassisConfigState{ConfigID: "ch_leaf@1", Status: api.ConfigStatus{State: api.ConfigStatus_APPLIED}})
+	other.Labels[testRealm.Label] = "pod-2"
+	s := newTestService(t, newDevice(t, "leaf1", &api.DeviceConfig{ChassisConfigID: "ch_leaf"},
+		&api.ChassisConfigState{ConfigID: "ch_leaf@1", Status: api.ConfigStatus{State: api.ConfigStatus_APPLIED}}), other)
+	ctx := context.TODO()
+	record := &api.ConfigRecord{ConfigID: "ch_leaf", Kind: configs.ChassisConfigKind}
+	assert.NoError(t, s.configStore.Add(ctx, record, configs.Artifacts{api.ChassisType: []byte(testChassisConfig)}))
+
+	// Invalid artifacts and stale versions are rejected
+	_, err := s.client.UpdateConfig(ctx, &dpapi.UpdateConfigRequest{ConfigId: "ch_leaf", Artifacts: map[string][]byte{api.ChassisType: []byte("description {")}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.client.UpdateConfig(ctx, &dpapi.UpdateConfigRequest{ConfigId: "ch_leaf", Artifacts: map[string][]byte{api.ChassisType: []byte(testChassisConfig)}, Version: 1000})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	history, err := s.configStore.GetHistory(ctx, "ch_leaf")
+	assert.NoError(t, err)
+	response, err := s.client.UpdateConfig(ctx, &dpapi.UpdateConfigRequest{ConfigId: "ch_leaf", Artifacts: map[string][]byte{api.ChassisType: []byte(testChassisConfig + "\n")},
+		Version: history.Version})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), response.Revision)
+	assert.NotEqual(t, history.Version, response.Version)
+
+	// The returned version is the one to base the next update on, while the version it replaced is stale
+	_, err = s.client.UpdateConfig(ctx, &dpapi.UpdateConfigRequest{ConfigId: "ch_leaf", Artifacts: map[string][]byte{api.ChassisType: []byte(testChassisConfig + "\n\n")},
+		Version: history.Version})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	// The device following the configuration is moved back to PENDING
+	device, err := s.client.GetDeviceStatus(ctx, &dpapi.GetDeviceStatusRequest{Id: "leaf1"})
+	assert.NoError(t, err)
+	assert.Equal(t, dpapi.ConfigStatus_PENDING, device.Status.Chassis.State)
+	assert.Equal(t, "ch_leaf", device.Status.Chassis.DesiredConfigId)
+
+	// and so is the device of the other realm, as the configurations are shared by all realms
+	object, err := s.topo.Get(ctx, "leaf2")
+	assert.NoError(t, err)
+	ccState := &api.ChassisConfigState{}
+	assert.NoError(t, object.GetAspect(ccState))
+	assert.Equal(t, api.ConfigStatus_PENDING, ccState.Status.State)
+
+	events, err := s.client.ListEvents(ctx, &dpapi.ListEventsRequest{Filter: &dpapi.EventFilter{ConfigId: "ch_leaf"}})
+	assert.NoError(t, err)
+	event, err := events.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, dpapi.Event_CONFIG_UPDATED, event.Event.Type)
+	assert.Equal(t, "ch_leaf@2", event.Event.ConfigId)
+	_, err = events.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestRollbackConfig(t *testing.T) {
+	s := newTestService(t, newDevice(t, "leaf1", &api.DeviceConfig{ChassisConfigID: "ch_leaf"},
+		&api.ChassisConfigState{ConfigID: "ch_leaf@2", Status: api.ConfigStatus{State: api.ConfigStatus_APPLIED}}))
+	ctx := context.TODO()
+	record := &api.ConfigRecord{ConfigID: "ch_leaf", Kind: configs.ChassisConfigKind}
+	assert.NoError(t, s.configStore.Add(ctx, record, configs.Artifacts{api.ChassisType: []byte(testChassisConfig)}))
+	updated, err := s.client.UpdateConfig(ctx, &dpapi.UpdateConfigRequest{ConfigId: "ch_leaf", Artifacts: map[string][]byte{api.ChassisType: []byte(testChassisConfig + "\n")}})
+	assert.NoError(t, err)
+
+	// The history lists the revisions along with the version returned by the update
+	history, err := s.client.GetHistory(ctx, &dpapi.GetHistoryRequest{ConfigId: "ch_leaf"})
+	assert.NoError(t, err)
+	assert.Len(t, history.Revisions, 2)
+	assert.Equal(t, uint64(2), history.Current)
+	assert.Equal(t, updated.Version, history.Version)
+	assert.Equal(t, configs.Digest([]byte(testChassisConfig)), history.Revisions[0].Digests[api.ChassisType])
+	assert.Equal(t, int64(len(testChassisConfig)+1), history.Revisions[1].Sizes[api.ChassisType])
+	_, err = s.client.GetHistory(ctx, &dpapi.GetHistoryRequest{ConfigId: "ch_spine"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.client.Rollback(ctx, &dpapi.RollbackRequest{ConfigId: "ch_leaf", Revision: 3})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.client.Rollback(ctx, &dpapi.RollbackRequest{ConfigId: "ch_leaf", Revision: 1})
+	assert.NoError(t, err)
+	history, err = s.client.GetHistory(ctx, &dpapi.GetHistoryRequest{ConfigId: "ch_leaf"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), history.Current)
+	assert.NotEqual(t, updated.Version, history.Version)
+
+	// The device following the configuration is moved back to PENDING, so that the prior revision is applied
+	device, err := s.client.GetDeviceStatus(ctx, &dpapi.GetDeviceStatusRequest{Id: "leaf1"})
+	assert.NoError(t, err)
+	assert.Equal(t, dpapi.ConfigStatus_PENDING, device.Status.Chassis.State)
+}
+
+func TestWatchConfigs(t *testing.T) {
+	s := newTestService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipeline := &api.ConfigRecord{ConfigID: "p4_leaf", Kind: configs.PipelineConfigKind}
+	assert.NoError(t, s.configStore.Add(ctx, pipeline, configs.Artifacts{api.P4InfoType: []byte(testP4Info), api.P4BinaryType: []byte("bin")}))
+	chassis := &api.ConfigRecord{ConfigID: "ch_leaf", Kind: configs.ChassisConfigKind}
+	assert.NoError(t, s.configStore.Add(ctx, chassis, configs.Artifacts{api.ChassisType: []byte(testChassisConfig)}))
+
+	// The existing configurations of the watched kind are replayed first
+	stream, err := s.client.WatchConfigs(ctx, &dpapi.WatchConfigsRequest{Kind: configs.ChassisConfigKind, Replay: true})
+	assert.NoError(t, err)
+	response, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, dpapi.WatchConfigsResponse_NONE, response.Type)
+	assert.Equal(t, "ch_leaf", response.Config.ConfigId)
+	assert.Equal(t, []string{api.ChassisType}, response.Config.Artifacts)
+
+	// and the changes of the configurations of the kind follow
+	_, err = s.client.UpdateConfig(ctx, &dpapi.UpdateConfigRequest{ConfigId: "ch_leaf", Artifacts: map[string][]byte{api.ChassisType: []byte(testChassisConfig + "\n")}})
+	assert.NoError(t, err)
+	response, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, dpapi.WatchConfigsResponse_UPDATED, response.Type)
+	assert.Equal(t, "ch_leaf", response.Config.ConfigId)
+
+	assert.NoError(t, s.configStore.Add(ctx, &api.ConfigRecord{ConfigID: "p4_spine", Kind: configs.PipelineConfigKind},
+		configs.Artifacts{api.P4InfoType: []byte(testP4Info), api.P4BinaryType: []byte("bin")}))
+	_, err = s.client.DeleteConfig(ctx, &dpapi.DeleteConfigRequest{ConfigId: "ch_leaf"})
+	assert.NoError(t, err)
+	// the update may be streamed twice, as both the record and the current revision of the configuration changed
+	for response.Type == dpapi.WatchConfigsResponse_UPDATED {
+		response, err = stream.Recv()
+		if !assert.NoError(t, err) {
+			return
+		}
+	}
+	assert.Equal(t, dpapi.WatchConfigsResponse_DELETED, response.Type)
+	assert.Equal(t, "ch_leaf", response.Config.ConfigId)
+}
+
+func TestDeleteConfig(t *testing.T) {
+	// the configuration is referenced only by a device of another realm
+	device := newDevice(t, "leaf2", &api.DeviceConfig{ChassisConfigID: "ch_leaf@1"}, nil)
+	device.Labels[testRealm.Label] = "pod-2"
+	s := newTestService(t, device)
+	ctx := context.TODO()
+	record := &api.ConfigRecord{ConfigID: "ch_leaf", Kind: configs.ChassisConfigKind}
+	assert.NoError(t, s.configStore.Add(ctx, record, configs.Artifacts{api.ChassisType: []byte(testChassisConfig)}))
+
+	_, err := s.client.DeleteConfig(ctx, &dpapi.DeleteConfigRequest{ConfigId: "ch_leaf"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "leaf2")
+	_, err = s.configStore.Get(ctx, "ch_leaf")
+	assert.NoError(t, err)
+
+	_, err = s.client.DeleteConfig(ctx, &dpapi.DeleteConfigRequest{ConfigId: "ch_leaf", Force: true})
+	assert.NoError(t, err)
+	_, err = s.configStore.Get(ctx, "ch_leaf")
+	assert.True(t, errors.IsNotFound(err))
+
+	_, err = s.client.DeleteConfig(ctx, &dpapi.DeleteConfigRequest{ConfigId: "ch_leaf", Force: true})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDeleteConfigPolicy(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.TODO()
+	record := &api.ConfigRecord{ConfigID: "ch_leaf", Kind: configs.ChassisConfigKind}
+	assert.NoError(t, s.configStore.Add(ctx, record, configs.Artifacts{api.ChassisType: []byte(testChassisConfig)}))
+	assert.NoError(t, s.policies.Create(ctx, &configs.AssignmentPolicy{ID: "leaves", ChassisConfigID: "ch_leaf"}))
+
+	_, err := s.client.DeleteConfig(ctx, &dpapi.DeleteConfigRequest{ConfigId: "ch_leaf"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "policy leaves")
+
+	assert.NoError(t, s.policies.Delete(ctx, "leaves"))
+	_, err = s.client.DeleteConfig(ctx, &dpapi.DeleteConfigRequest{ConfigId: "ch_leaf"})
+	assert.NoError(t, err)
+}
+
+func TestUploadDownload(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.TODO()
+
+	// An artifact exceeding the maximum gRPC message size is uploaded and downloaded in chunks
+	binary := bytes.Repeat([]byte("p4 device binary "), 512*1024)
+	upload := func(header *dpapi.UploadHeader, digests map[string]string) (*dpapi.UploadConfigResponse, error) {
+		stream, err := s.client.UploadConfig(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(&dpapi.UploadConfigRequest{Request: &dpapi.UploadConfigRequest_Header{Header: header}}))
+		chunks := map[string][]byte{api.P4InfoType: []byte(testP4Info), api.P4BinaryType: binary}
+		for artifact, data := range chunks {
+			for len(data) > 0 {
+				n := len(data)
+				if n > downloadChunkSize {
+					n = downloadChunkSize
+				}
+				chunk := &dpapi.ArtifactChunk{Artifact: artifact, Data: data[:n]}
+				assert.NoError(t, stream.Send(&dpapi.UploadConfigRequest{Request: &dpapi.UploadConfigRequest_Chunk{Chunk: chunk}}))
+				data = data[n:]
+			}
+		}
+		trailer := &dpapi.UploadTrailer{Digests: digests}
+		assert.NoError(t, stream.Send(&dpapi.UploadConfigRequest{Request: &dpapi.UploadConfigRequest_Trailer{Trailer: trailer}}))
+		return stream.CloseAndRecv()
+	}
+
+	_, err := upload(&dpapi.UploadHeader{ConfigId: "fp_leaf", Kind: configs.PipelineConfigKind},
+		map[string]string{api.P4BinaryType: configs.Digest([]byte("other binary"))})
+	assert.Equal(t, codes.DataLoss, status.Code(err))
+
+	response, err := upload(&dpapi.UploadHeader{ConfigId: "fp_leaf", Kind: configs.PipelineConfigKind},
+		map[string]string{api.P4BinaryType: configs.Digest(binary)})
+	assert.NoError(t, err)
+	assert.Equal(t, configs.Digest(binary), response.Digests[api.P4BinaryType])
+
+	response, err = upload(&dpapi.UploadHeader{ConfigId: "fp_leaf", Revision: true}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), response.Revision)
+
+	stream, err := s.client.DownloadArtifact(ctx, &dpapi.DownloadArtifactRequest{ConfigId: "fp_leaf@1", Artifact: api.P4BinaryType})
+	assert.NoError(t, err)
+	var data []byte
+	var digest string
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if !assert.NoError(t, err) {
+			return
+		}
+		if digest == "" {
+			digest = chunk.Digest
+		}
+		data = append(data, chunk.Data...)
+	}
+	assert.Equal(t, configs.Digest(binary), digest)
+	assert.Equal(t, binary, data)
+
+	stream, err = s.client.DownloadArtifact(ctx, &dpapi.DownloadArtifactRequest{ConfigId: "fp_leaf", Artifact: "missing"})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSetApplyMode(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.TODO()
+	record := &api.ConfigRecord{ConfigID: "ch_leaf", Kind: configs.ChassisConfigKind}
+	assert.NoError(t, s.configStore.Add(ctx, record, configs.Artifacts{api.ChassisType: []byte(testChassisConfig)}))
+
+	// Only pipeline configurations have an apply mode
+	_, err := s.client.SetApplyMode(ctx, &dpapi.SetApplyModeRequest{ConfigId: "ch_leaf", Mode: dpapi.ApplyMode_VERIFY_THEN_COMMIT})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.client.SetApplyMode(ctx, &dpapi.SetApplyModeRequest{ConfigId: "p4_leaf", Mode: dpapi.ApplyMode_VERIFY_THEN_COMMIT})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRolloutRealm(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.TODO()
+	for _, configID := range []api.ConfigID{"ch_leaf", "ch_spine"} {
+		_, err := s.provisioner.Add(ctx, &api.AddConfigRequest{Config: &api.Config{Record: &api.ConfigRecord{ConfigID: configID, Kind: configs.ChassisConfigKind},
+			Artifacts: map[string][]byte{api.ChassisType: []byte(testChassisConfig)}}})
+		assert.NoError(t, err)
+	}
+
+	// rollouts are created in the realm of the provisioner, and those of other realms are not visible to it
+	_, err := s.client.CreateRollout(ctx, &dpapi.CreateRolloutRequest{Rollout: &dpapi.Rollout{Id: "leaf-upgrade",
+		Kind: configs.ChassisConfigKind, FromConfigId: "ch_leaf", ToConfigId: "ch_spine", BatchSize: 1}})
+	assert.NoError(t, err)
+	rollout, err := s.rollouts.Get(ctx, "leaf-upgrade")
+	assert.NoError(t, err)
+	assert.Equal(t, testRealm.Value, rollout.Realm)
+	assert.NoError(t, s.rollouts.Create(ctx, &configs.Rollout{ID: "pod-2-upgrade", Realm: "pod-2", Kind: configs.ChassisConfigKind,
+		FromConfigID: "ch_leaf", ToConfigID: "ch_spine", BatchSize: 1}))
+
+	list, err := s.client.ListRollouts(ctx, &dpapi.ListRolloutsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Rollouts, 1)
+	assert.Equal(t, "leaf-upgrade", list.Rollouts[0].Id)
+	_, err = s.client.GetRollout(ctx, &dpapi.GetRolloutRequest{Id: "pod-2-upgrade"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.client.AbortRollout(ctx, &dpapi.AbortRolloutRequest{Id: "pod-2-upgrade"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	rollout, err = s.rollouts.Get(ctx, "pod-2-upgrade")
+	assert.NoError(t, err)
+	assert.Equal(t, configs.RolloutPending, rollout.State)
+}
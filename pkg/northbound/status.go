@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+
+	dpapi "github.com/onosproject/device-provisioner/api/deviceprovisioner"
+	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	"github.com/onosproject/device-provisioner/pkg/store/configs"
+	api "github.com/onosproject/onos-api/go/onos/provisioner"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// GetDeviceStatus returns the provisioning status of the specified device
+func (s *Server) GetDeviceStatus(ctx context.Context, request *dpapi.GetDeviceStatusRequest) (*dpapi.GetDeviceStatusResponse, error) {
+	log.Infof("Received get device status request: %+v", request)
+	object, err := s.topo.Get(ctx, topoapi.ID(request.Id))
+	if err != nil {
+		log.Warnf("Failed retrieving device %s: %v", request.Id, err)
+		return nil, errors.Status(err).Err()
+	}
+	policies, err := s.policies.List(ctx)
+	if err != nil {
+		log.Warnf("Failed listing assignment policies: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+	// devices of the realm which no configuration is assigned to are reported as they are by ListDeviceStatus
+	if !s.inRealm(object) {
+		return nil, errors.Status(errors.NewNotFound("device %s is not in the realm", request.Id)).Err()
+	}
+	return &dpapi.GetDeviceStatusResponse{Status: newDeviceStatus(object, policies)}, nil
+}
+
+// Returns true if the object is one of the realm devices, as matched by the realm query filter
+func (s *Server) inRealm(object *topoapi.Object) bool {
+	if _, ok := object.Obj.(*topoapi.Object_Entity); !ok {
+		return false
+	}
+	if s.realmOptions != nil && object.Labels[s.realmOptions.Label] != s.realmOptions.Value {
+		return false
+	}
+	return object.GetAspect(&topoapi.StratumAgents{}) == nil
+}
+
+// ListDeviceStatus streams the provisioning status of all devices in the realm
+func (s *Server) ListDeviceStatus(request *dpapi.ListDeviceStatusRequest, server dpapi.DeviceProvisionerService_ListDeviceStatusServer) error {
+	log.Infof("Received list device status request: %+v", request)
+	policies, err := s.policies.List(server.Context())
+	if err != nil {
+		log.Warnf("Failed listing assignment policies: %v", err)
+		return errors.Status(err).Err()
+	}
+	ch := make(chan *topoapi.Object)
+	if err = s.topo.Query(server.Context(), ch, utils.RealmQueryFilter(s.realmOptions)); err != nil {
+		log.Warnf("Failed querying realm devices: %v", err)
+		return errors.Status(err).Err()
+	}
+	for object := range ch {
+		if err = server.Send(&dpapi.ListDeviceStatusResponse{Status: newDeviceStatus(object, policies)}); err != nil {
+			log.Warnf("Unable to send device status for %s: %v", object.ID, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// WatchDeviceStatus streams the provisioning status of the devices in the realm as it changes, starting with
+// the status of the existing devices
+func (s *Server) WatchDeviceStatus(request *dpapi.WatchDeviceStatusRequest, server dpapi.DeviceProvisionerService_WatchDeviceStatusServer) error {
+	log.Infof("Received watch device status request: %+v", request)
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
+	ch := make(chan topoapi.Event)
+	// the watches of the clients do not count toward the readiness of the provisioner
+	if err := s.topo.WatchUntracked(ctx, ch, utils.RealmQueryFilter(s.realmOptions)); err != nil {
+		log.Warnf("Failed watching realm devices: %v", err)
+		return errors.Status(err).Err()
+	}
+	for event := range ch {
+		object := event.Object
+		policies, err := s.policies.List(ctx)
+		if err != nil {
+			log.Warnf("Failed listing assignment policies: %v", err)
+		}
+		response := &dpapi.WatchDeviceStatusResponse{
+			Status:  newDeviceStatus(&object, policies),
+			Removed: event.Type == topoapi.EventType_REMOVED,
+		}
+		if err = server.Send(response); err != nil {
+			log.Warnf("Unable to send device status for %s: %v", object.ID, err)
+			// drain the events until the topo watch notices the cancellation
+			cancel()
+			for range ch {
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// Builds the provisioning status of the device from its DeviceConfig, or the configurations assigned to it
+// by the policies, and its configuration state aspects; the desired configurations of a device which no
+// configuration is assigned to are empty
+func newDeviceStatus(object *topoapi.Object, policies []*configs.AssignmentPolicy) *dpapi.DeviceStatus {
+	status := &dpapi.DeviceStatus{
+		Id:       string(object.ID),
+		Pipeline: &dpapi.ConfigStatus{},
+		Chassis:  &dpapi.ConfigStatus{},
+	}
+	deviceConfig, _ := utils.AssignedDeviceConfig(object, policies)
+
+	status.Pipeline.DesiredConfigId = string(deviceConfig.PipelineConfigID)
+	pcState := &api.PipelineConfigState{}
+	if err := object.GetAspect(pcState); err == nil {
+		attempts := utils.GetConfigAttempts(object, utils.PipelineAttemptsAspect, pcState.ConfigID)
+		setConfigStatus(status.Pipeline, pcState.ConfigID, pcState.Status.State, attempts)
+		status.Pipeline.Cookie = pcState.Cookie
+		status.Pipeline.Updated = timestamp(pcState.Updated)
+	}
+
+	status.Chassis.DesiredConfigId = string(deviceConfig.ChassisConfigID)
+	ccState := &api.ChassisConfigState{}
+	if err := object.GetAspect(ccState); err == nil {
+		attempts := utils.GetConfigAttempts(object, utils.ChassisAttemptsAspect, ccState.ConfigID)
+		setConfigStatus(status.Chassis, ccState.ConfigID, ccState.Status.State, attempts)
+		status.Chassis.Updated = timestamp(ccState.Updated)
+	}
+	return status
+}
+
+// Fills in the status from the configuration state and the attempts tracked for the configuration; the
+// configuration of the state is the applied one only in APPLIED state, otherwise the last applied one is taken
+// from the attempts
+func setConfigStatus(status *dpapi.ConfigStatus, configID api.ConfigID, state api.ConfigStatus_State, attempts *utils.ConfigAttempts) {
+	status.TargetConfigId = string(configID)
+	status.State = configState(state)
+	status.AppliedConfigId = string(attempts.AppliedConfigID)
+	if state == api.ConfigStatus_APPLIED {
+		status.AppliedConfigId = string(configID)
+	}
+	status.Attempts = attempts.Attempts
+	status.NextAttempt = timestamp(attempts.NextAttempt)
+	status.GaveUp = attempts.GaveUp
+	status.LastError = attempts.LastError
+	status.LastErrorCode = attempts.LastErrorCode
+	status.LastAttempt = timestamp(attempts.LastAttempt)
+	status.LastSuccess = timestamp(attempts.LastSuccess)
+	status.AppliedDigest = attempts.AppliedDigest
+}
+
+// Returns the NB API state of the given configuration state; unknown states are reported as PENDING
+func configState(state api.ConfigStatus_State) dpapi.ConfigStatus_State {
+	switch state {
+	case api.ConfigStatus_APPLIED:
+		return dpapi.ConfigStatus_APPLIED
+	case api.ConfigStatus_FAILED:
+		return dpapi.ConfigStatus_FAILED
+	default:
+		return dpapi.ConfigStatus_PENDING
+	}
+}
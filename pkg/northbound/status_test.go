@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	dpapi "github.com/onosproject/device-provisioner/api/deviceprovisioner"
+	"github.com/onosproject/device-provisioner/pkg/controller/utils"
+	api "github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Lists the provisioning status of the realm devices, keyed by the device IDs
+func listDeviceStatus(t *testing.T, s *testService) map[string]*dpapi.DeviceStatus {
+	stream, err := s.client.ListDeviceStatus(context.TODO(), &dpapi.ListDeviceStatusRequest{})
+	assert.NoError(t, err)
+	devices := make(map[string]*dpapi.DeviceStatus)
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return devices
+		}
+		if !assert.NoError(t, err) {
+			return devices
+		}
+		devices[response.Status.Id] = response.Status
+	}
+}
+
+func TestUnassignedDeviceStatus(t *testing.T) {
+	other := newDevice(t, "leaf2", nil, nil)
+	other.Labels[testRealm.Label] = "pod-2"
+	s := newTestService(t, newDevice(t, "leaf1", nil, nil), other)
+	ctx := context.TODO()
+
+	// A realm device which no configuration is assigned to is reported by both Get and List
+	response, err := s.client.GetDeviceStatus(ctx, &dpapi.GetDeviceStatusRequest{Id: "leaf1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "", response.Status.Pipeline.DesiredConfigId)
+	assert.Equal(t, "", response.Status.Chassis.DesiredConfigId)
+	devices := listDeviceStatus(t, s)
+	assert.Len(t, devices, 1)
+	assert.Equal(t, "", devices["leaf1"].Pipeline.DesiredConfigId)
+
+	// Devices of other realms are reported by neither
+	_, err = s.client.GetDeviceStatus(ctx, &dpapi.GetDeviceStatusRequest{Id: "leaf2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.client.GetDeviceStatus(ctx, &dpapi.GetDeviceStatusRequest{Id: "leaf3"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDeviceStatus(t *testing.T) {
+	lastAttempt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	tests := []struct {
+		name     string
+		state    api.ConfigStatus_State
+		attempts *utils.ConfigAttempts
+		expected *dpapi.ConfigStatus
+	}{
+		{
+			name:     "pending",
+			state:    api.ConfigStatus_PENDING,
+			expected: &dpapi.ConfigStatus{State: dpapi.ConfigStatus_PENDING},
+		},
+		{
+			name:     "applied",
+			state:    api.ConfigStatus_APPLIED,
+			attempts: &utils.ConfigAttempts{ConfigID: "p4_leaf@2", LastAttempt: lastAttempt, LastSuccess: lastAttempt, AppliedDigest: "sha256:1234"},
+			expected: &dpapi.ConfigStatus{State: dpapi.ConfigStatus_APPLIED, AppliedConfigId: "p4_leaf@2",
+				LastAttempt: timestamppb.New(lastAttempt), LastSuccess: timestamppb.New(lastAttempt), AppliedDigest: "sha256:1234"},
+		},
+		{
+			name:  "failed",
+			state: api.ConfigStatus_FAILED,
+			attempts: &utils.ConfigAttempts{ConfigID: "p4_leaf@2", Attempts: 3, GaveUp: true, LastError: "device unreachable",
+				LastErrorCode: "Unavailable", LastAttempt: lastAttempt, AppliedConfigID: "p4_leaf@1"},
+			expected: &dpapi.ConfigStatus{State: dpapi.ConfigStatus_FAILED, AppliedConfigId: "p4_leaf@1", Attempts: 3, GaveUp: true,
+				LastError: "device unreachable", LastErrorCode: "Unavailable", LastAttempt: timestamppb.New(lastAttempt)},
+		},
+		{
+			// attempts tracked for another configuration are reset, retaining the last applied configuration
+			name:     "stale attempts",
+			state:    api.ConfigStatus_PENDING,
+			attempts: &utils.ConfigAttempts{ConfigID: "p4_leaf@1", Attempts: 2, LastError: "device unreachable", AppliedConfigID: "p4_leaf@1"},
+			expected: &dpapi.ConfigStatus{State: dpapi.ConfigStatus_PENDING, AppliedConfigId: "p4_leaf@1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := time.Now().Truncate(time.Second)
+			device := newDevice(t, "leaf1", &api.DeviceConfig{PipelineConfigID: "p4_leaf"}, nil)
+			assert.NoError(t, device.SetAspect(&api.PipelineConfigState{ConfigID: "p4_leaf@2", Cookie: 7, Updated: updated,
+				Status: api.ConfigStatus{State: tt.state}}))
+			if tt.attempts != nil {
+				data, err := json.Marshal(tt.attempts)
+				assert.NoError(t, err)
+				assert.NoError(t, device.SetAspectBytes(utils.PipelineAttemptsAspect, data))
+			}
+			s := newTestService(t, device)
+
+			tt.expected.DesiredConfigId = "p4_leaf"
+			tt.expected.TargetConfigId = "p4_leaf@2"
+			tt.expected.Cookie = 7
+			tt.expected.Updated = timestamppb.New(updated)
+			response, err := s.client.GetDeviceStatus(context.TODO(), &dpapi.GetDeviceStatusRequest{Id: "leaf1"})
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(tt.expected, response.Status.Pipeline), "%v", response.Status.Pipeline)
+			assert.True(t, proto.Equal(&dpapi.ConfigStatus{}, response.Status.Chassis), "%v", response.Status.Chassis)
+			assert.True(t, proto.Equal(response.Status, listDeviceStatus(t, s)["leaf1"]))
+		})
+	}
+}
+
+func TestConfigState(t *testing.T) {
+	// the states are mapped by name rather than by their numeric values
+	for name, value := range api.ConfigStatus_State_value {
+		assert.Equal(t, name, configState(api.ConfigStatus_State(value)).String())
+	}
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"io"
+
+	dpapi "github.com/onosproject/device-provisioner/api/deviceprovisioner"
+	"github.com/onosproject/device-provisioner/pkg/store/configs"
+	api "github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// downloadChunkSize is the size of the artifact content chunks streamed to the clients
+const downloadChunkSize = 1 << 20
+
+// UploadConfig adds a configuration, or a new revision of an existing one, whose artifacts are streamed in chunks;
+// the artifacts are staged outside of memory and are validated and verified against the digests given by the client
+// before the configuration is committed
+func (s *Server) UploadConfig(server dpapi.DeviceProvisionerService_UploadConfigServer) error {
+	ctx := server.Context()
+	request, err := server.Recv()
+	if err != nil {
+		return err
+	}
+	header := request.GetHeader()
+	if header == nil {
+		return errors.Status(errors.NewInvalid("upload must start with a header")).Err()
+	}
+	log.Infof("Received upload request: %+v", header)
+	if !header.Revision && header.ApplyMode != dpapi.ApplyMode_VERIFY_AND_COMMIT && header.Kind != configs.PipelineConfigKind {
+		return errors.Status(errors.NewInvalid("apply mode can be set only for %s configurations", configs.PipelineConfigKind)).Err()
+	}
+	record := &api.ConfigRecord{ConfigID: api.ConfigID(header.ConfigId), Kind: header.Kind}
+	if header.Revision {
+		if record, err = s.configStore.Get(ctx, record.ConfigID); err != nil {
+			log.Warnf("Failed uploading configuration %s: %v", header.ConfigId, err)
+			return errors.Status(err).Err()
+		}
+	}
+
+	upload, err := s.configStore.NewUpload()
+	if err != nil {
+		log.Warnf("Failed starting upload of configuration %s: %v", header.ConfigId, err)
+		return errors.Status(err).Err()
+	}
+	defer upload.Abort()
+	var trailer *dpapi.UploadTrailer
+	for trailer == nil {
+		request, err = server.Recv()
+		if err == io.EOF {
+			return errors.Status(errors.NewInvalid("upload must end with a trailer")).Err()
+		}
+		if err != nil {
+			log.Warnf("Failed receiving upload of configuration %s: %v", header.ConfigId, err)
+			return err
+		}
+		switch r := request.Request.(type) {
+		case *dpapi.UploadConfigRequest_Chunk:
+			if err = upload.Write(r.Chunk.Artifact, r.Chunk.Data); err != nil {
+				log.Warnf("Failed staging upload of configuration %s: %v", header.ConfigId, err)
+				return errors.Status(err).Err()
+			}
+		case *dpapi.UploadConfigRequest_Trailer:
+			trailer = r.Trailer
+		default:
+			return errors.Status(errors.NewInvalid("unexpected upload message")).Err()
+		}
+	}
+
+	if err = upload.Validate(record.Kind); err != nil {
+		log.Warnf("Rejecting invalid configuration %s: %v", header.ConfigId, err)
+		return errors.Status(err).Err()
+	}
+	digests := upload.Digests()
+	if !header.Revision {
+		// the apply mode is added along with the configuration, so that it is never applied in the default mode
+		applyMode := ""
+		if header.ApplyMode != dpapi.ApplyMode_VERIFY_AND_COMMIT {
+			applyMode = header.ApplyMode.String()
+		}
+		if err = upload.Commit(ctx, record, applyMode, trailer.Digests); err != nil {
+			log.Warnf("Failed adding configuration %s: %v", header.ConfigId, err)
+			return artifactsStatus(err).Err()
+		}
+		s.recordConfigEvent(ctx, configs.ConfigAdded, record.ConfigID, record.Kind)
+		return server.SendAndClose(&dpapi.UploadConfigResponse{Digests: digests})
+	}
+	history, err := upload.CommitRevision(ctx, record.ConfigID, trailer.Digests, header.Version)
+	if err != nil {
+		log.Warnf("Failed updating configuration %s: %v", header.ConfigId, err)
+		return revisionStatus(err).Err()
+	}
+	if err = s.configUpdated(ctx, record, history.Current); err != nil {
+		return errors.Status(err).Err()
+	}
+	return server.SendAndClose(&dpapi.UploadConfigResponse{Revision: history.Current, Digests: digests, Version: history.Version})
+}
+
+// DownloadArtifact streams the content of an artifact of a configuration revision in chunks; the content is
+// verified as it is read and the stream fails with DATA_LOSS at its end if the verification fails
+func (s *Server) DownloadArtifact(request *dpapi.DownloadArtifactRequest, server dpapi.DeviceProvisionerService_DownloadArtifactServer) error {
+	log.Infof("Received download request: %+v", request)
+	reader, digest, err := s.configStore.OpenArtifact(server.Context(), api.ConfigID(request.ConfigId), request.Artifact)
+	if err != nil {
+		log.Warnf("Failed opening artifact %s of %s: %v", request.Artifact, request.ConfigId, err)
+		return artifactsStatus(err).Err()
+	}
+	defer reader.Close()
+
+	// the first message carries the digest, even if the artifact is empty
+	response := &dpapi.DownloadArtifactResponse{Digest: digest}
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 || (err == io.EOF && response.Digest != "") {
+			response.Data = buf[:n]
+			if err := server.Send(response); err != nil {
+				log.Warnf("Unable to send artifact %s of %s: %v", request.Artifact, request.ConfigId, err)
+				return err
+			}
+			response = &dpapi.DownloadArtifactResponse{}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			log.Warnf("Failed reading artifact %s of %s: %v", request.Artifact, request.ConfigId, err)
+			return artifactsStatus(err).Err()
+		}
+	}
+}
@@ -6,7 +6,13 @@
 package southbound
 
 import (
+	"context"
+	"time"
+
+	"github.com/onosproject/device-provisioner/pkg/metrics"
+	"github.com/onosproject/device-provisioner/pkg/tracing"
 	"github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	utils "github.com/onosproject/onos-net-lib/pkg/gnmiutils"
 	"github.com/onosproject/onos-net-lib/pkg/stratum"
@@ -16,7 +22,10 @@ import (
 var log = logging.GetLogger()
 
 // SetChassisConfig sets the chassis configuration on the device via gNMI
-func SetChassisConfig(object *topo.Object, config []byte) error {
+func SetChassisConfig(ctx context.Context, object *topo.Object, config []byte) (err error) {
+	ctx, span := tracing.Start(ctx, "SetChassisConfig", object.ID)
+	defer func() { tracing.End(span, err) }()
+
 	// Connect to the device using gNMI
 	device, err := stratum.NewStratumGNMI(object, true)
 	if err != nil {
@@ -25,11 +34,43 @@ func SetChassisConfig(object *topo.Object, config []byte) error {
 	}
 	defer device.Disconnect()
 
-	_, err = device.Client.Set(device.Context, &gnmi.SetRequest{
+	start := time.Now()
+	_, err = device.Client.Set(ctx, &gnmi.SetRequest{
 		Replace: []*gnmi.Update{{
 			Path: utils.ToPath(""),
 			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_BytesVal{BytesVal: config}},
 		}},
 	})
+	metrics.ObserveGNMISet(start, err)
 	return err
 }
+
+// GetChassisConfig gets the chassis configuration reported by the device via gNMI; returns NotFound error if the
+// device reports no chassis configuration
+func GetChassisConfig(ctx context.Context, object *topo.Object) (config []byte, err error) {
+	ctx, span := tracing.Start(ctx, "GetChassisConfig", object.ID)
+	defer func() { tracing.End(span, err) }()
+
+	// Connect to the device using gNMI
+	device, err := stratum.NewStratumGNMI(object, true)
+	if err != nil {
+		log.Warnf("Unable to connect to Stratum device gNMI %s: %+v", object.ID, err)
+		return nil, err
+	}
+	defer device.Disconnect()
+
+	start := time.Now()
+	resp, err := device.Client.Get(ctx, &gnmi.GetRequest{
+		Path:     []*gnmi.Path{utils.ToPath("")},
+		Type:     gnmi.GetRequest_CONFIG,
+		Encoding: gnmi.Encoding_PROTO,
+	})
+	metrics.ObserveGNMIGet(start, err)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Notification) == 0 || len(resp.Notification[0].Update) == 0 {
+		return nil, errors.NewNotFound("device %s reported no chassis config", object.ID)
+	}
+	return resp.Notification[0].Update[0].Val.GetBytesVal(), nil
+}
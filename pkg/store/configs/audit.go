@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/atomix/go-sdk/pkg/primitive"
+	"github.com/atomix/go-sdk/pkg/primitive/indexedmap"
+	"github.com/atomix/go-sdk/pkg/types"
+	"github.com/google/uuid"
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// DefaultAuditCapacity is the default number of events retained by the event store
+const DefaultAuditCapacity = 10000
+
+// AuditEventType is the type of provisioning event
+type AuditEventType string
+
+const (
+	// ConfigAdded indicates that a configuration was added to the inventory
+	ConfigAdded AuditEventType = "CONFIG_ADDED"
+	// ConfigUpdated indicates that a new revision of a configuration was added
+	ConfigUpdated AuditEventType = "CONFIG_UPDATED"
+	// ConfigDeleted indicates that a configuration was removed from the inventory
+	ConfigDeleted AuditEventType = "CONFIG_DELETED"
+	// StateChanged indicates that the configuration state of a device changed
+	StateChanged AuditEventType = "STATE_CHANGED"
+	// PushStarted indicates that the provisioner started applying a configuration to a device
+	PushStarted AuditEventType = "PUSH_STARTED"
+	// PushSucceeded indicates that a configuration was applied to a device
+	PushSucceeded AuditEventType = "PUSH_SUCCEEDED"
+	// PushFailed indicates that a configuration failed to be applied to a device
+	PushFailed AuditEventType = "PUSH_FAILED"
+	// DriftDetected indicates that a device no longer runs the configuration applied to it
+	DriftDetected AuditEventType = "DRIFT_DETECTED"
+)
+
+// AuditEvent is an entry of the provisioning audit log
+type AuditEvent struct {
+	// Index is the position of the event in the log, increasing with every recorded event
+	Index uint64         `json:"-"`
+	Type  AuditEventType `json:"type"`
+	Time  time.Time      `json:"time"`
+	// Actor identifies who caused the event: the NB client for configuration changes, the provisioner otherwise
+	Actor    string               `json:"actor,omitempty"`
+	TargetID topo.ID              `json:"targetID,omitempty"`
+	ConfigID provisioner.ConfigID `json:"configID,omitempty"`
+	// Kind is the kind of the configuration, i.e. pipeline or chassis
+	Kind string `json:"kind,omitempty"`
+	// State is the configuration state of the device following the event, if any
+	State   string `json:"state,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// AuditFilter selects events; the zero filter selects all events
+type AuditFilter struct {
+	TargetID topo.ID
+	// ConfigID selects the events of the configuration; a reference without revision selects the events
+	// of all its revisions
+	ConfigID provisioner.ConfigID
+	// Start and End bound the time of the events; End is exclusive and either is unbounded if zero
+	Start time.Time
+	End   time.Time
+}
+
+// Matches returns true if the event is selected by the filter
+func (f AuditFilter) Matches(event *AuditEvent) bool {
+	if f.TargetID != "" && f.TargetID != event.TargetID {
+		return false
+	}
+	if f.ConfigID != "" && f.ConfigID != event.ConfigID {
+		if strings.Contains(string(f.ConfigID), RevisionSeparator) {
+			return false
+		}
+		if id, _, err := ParseRef(event.ConfigID); err != nil || id != f.ConfigID {
+			return false
+		}
+	}
+	if !f.Start.IsZero() && event.Time.Before(f.Start) {
+		return false
+	}
+	if !f.End.IsZero() && !event.Time.Before(f.End) {
+		return false
+	}
+	return true
+}
+
+// AuditStore is a bounded log of provisioning events; the oldest events are discarded once the capacity is reached
+type AuditStore interface {
+	io.Closer
+
+	// Record appends the event to the log, setting its index and, unless set, its time
+	Record(ctx context.Context, event *AuditEvent) error
+
+	// List returns the events selected by the filter in the order in which they were recorded
+	List(ctx context.Context, filter AuditFilter) ([]*AuditEvent, error)
+
+	// Watch streams the events selected by the filter as they are recorded, starting with the retained events;
+	// this is a non-blocking method and the channel is closed once the context is done or the event stream fails
+	Watch(ctx context.Context, filter AuditFilter, ch chan<- *AuditEvent) error
+}
+
+// NewAtomixAuditStore returns a new persistent store for provisioning events retaining up to the given number
+// of events
+func NewAtomixAuditStore(client primitive.Client, capacity int) (AuditStore, error) {
+	if capacity <= 0 {
+		return nil, errors.NewInvalid("event store capacity must be positive")
+	}
+	events, err := indexedmap.NewBuilder[string, *AuditEvent](client, "onos-device-provisioning-events").
+		Tag("device-provisioner", "device-provisioning-events").
+		Codec(types.JSON[*AuditEvent]()).
+		Get(context.Background())
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	return &atomixAuditStore{events: events, capacity: capacity}, nil
+}
+
+type atomixAuditStore struct {
+	events   indexedmap.IndexedMap[string, *AuditEvent]
+	capacity int
+}
+
+func (s *atomixAuditStore) Record(ctx context.Context, event *AuditEvent) error {
+	if event.Type == "" {
+		return errors.NewInvalid("event type cannot be empty")
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	entry, err := s.events.Append(ctx, uuid.NewString(), event)
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	event.Index = uint64(entry.Index)
+	return s.prune(ctx)
+}
+
+// Removes the oldest events beyond the capacity
+func (s *atomixAuditStore) prune(ctx context.Context) error {
+	n, err := s.events.Len(ctx)
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	for ; n > s.capacity; n-- {
+		index, err := s.events.FirstIndex(ctx)
+		if err != nil {
+			err = errors.FromAtomix(err)
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		// concurrent records may remove the same event
+		if _, err = s.events.RemoveIndex(ctx, index); err != nil && !errors.IsNotFound(errors.FromAtomix(err)) {
+			return errors.FromAtomix(err)
+		}
+	}
+	return nil
+}
+
+func (s *atomixAuditStore) List(ctx context.Context, filter AuditFilter) ([]*AuditEvent, error) {
+	stream, err := s.events.List(ctx)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	events := make([]*AuditEvent, 0)
+	for {
+		entry, err := stream.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.FromAtomix(err)
+		}
+		if event := newAuditEvent(entry); filter.Matches(event) {
+			events = append(events, event)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Index < events[j].Index
+	})
+	return events, nil
+}
+
+func (s *atomixAuditStore) Watch(ctx context.Context, filter AuditFilter, ch chan<- *AuditEvent) error {
+	// subscribe to events before listing the retained entries, so that no events are missed in between
+	stream, err := s.events.Events(ctx)
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	events, err := s.List(ctx, filter)
+	if err != nil {
+		return err
+	}
+	go func() {
+		defer close(ch)
+		send := func(event *AuditEvent) bool {
+			select {
+			case ch <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		var last uint64
+		for _, event := range events {
+			if !send(event) {
+				return
+			}
+			last = event.Index
+		}
+		for {
+			e, err := stream.Next()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					log.Warnf("Provisioning event stream failed: %+v", errors.FromAtomix(err))
+				}
+				return
+			}
+			inserted, ok := e.(*indexedmap.Inserted[string, *AuditEvent])
+			if !ok {
+				continue
+			}
+			// skip the events already listed
+			event := newAuditEvent(inserted.Entry)
+			if event.Index <= last || !filter.Matches(event) {
+				continue
+			}
+			if !send(event) {
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *atomixAuditStore) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.events.Close(ctx); err != nil {
+		return errors.FromAtomix(err)
+	}
+	return nil
+}
+
+func newAuditEvent(entry *indexedmap.Entry[string, *AuditEvent]) *AuditEvent {
+	event := entry.Value
+	event.Index = uint64(entry.Index)
+	return event
+}
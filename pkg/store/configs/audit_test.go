@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestAuditStore(t *testing.T) {
+	cluster := newTestCluster(t)
+	_, err := NewAtomixAuditStore(cluster, 0)
+	assert.True(t, errors.IsInvalid(err))
+	store, err := NewAtomixAuditStore(cluster, 3)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	start := time.Now()
+	assert.True(t, errors.IsInvalid(store.Record(ctx, &AuditEvent{})))
+	assert.NoError(t, store.Record(ctx, &AuditEvent{Type: ConfigAdded, Actor: "admin", ConfigID: "fabric-leaf@1", Kind: PipelineConfigKind}))
+	assert.NoError(t, store.Record(ctx, &AuditEvent{Type: PushStarted, TargetID: "leaf1", ConfigID: "fabric-leaf@1", Kind: PipelineConfigKind}))
+	failed := &AuditEvent{Type: PushFailed, TargetID: "leaf2", ConfigID: "fabric-leaf@1", Kind: PipelineConfigKind, Message: "unavailable"}
+	assert.NoError(t, store.Record(ctx, failed))
+	assert.False(t, failed.Time.Before(start))
+
+	events, err := store.List(ctx, AuditFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, ConfigAdded, events[0].Type)
+	assert.Equal(t, "admin", events[0].Actor)
+	assert.Equal(t, failed.Index, events[2].Index)
+
+	events, err = store.List(ctx, AuditFilter{TargetID: "leaf2"})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "unavailable", events[0].Message)
+
+	// references without revision select all revisions of the configuration
+	events, err = store.List(ctx, AuditFilter{ConfigID: "fabric-leaf"})
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	events, err = store.List(ctx, AuditFilter{ConfigID: "fabric-leaf@2"})
+	assert.NoError(t, err)
+	assert.Len(t, events, 0)
+
+	events, err = store.List(ctx, AuditFilter{End: start})
+	assert.NoError(t, err)
+	assert.Len(t, events, 0)
+
+	// watches replay the retained events before the new ones
+	ch := make(chan *AuditEvent, depth)
+	assert.NoError(t, store.Watch(ctx, AuditFilter{TargetID: "leaf1"}, ch))
+	event := <-ch
+	assert.Equal(t, PushStarted, event.Type)
+
+	// the oldest events are discarded beyond the capacity
+	assert.NoError(t, store.Record(ctx, &AuditEvent{Type: PushSucceeded, TargetID: "leaf1", ConfigID: "fabric-leaf@1", Kind: PipelineConfigKind}))
+	event = <-ch
+	assert.Equal(t, PushSucceeded, event.Type)
+
+	events, err = store.List(ctx, AuditFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, PushStarted, events[0].Type)
+
+	assert.NoError(t, store.Close())
+}
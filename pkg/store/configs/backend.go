@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/atomix/go-sdk/pkg/primitive"
+	_map "github.com/atomix/go-sdk/pkg/primitive/map"
+	"github.com/atomix/go-sdk/pkg/types"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+const (
+	// DirBackend stores artifacts in a directory, typically on a shared persistent volume
+	DirBackend = "dir"
+	// ObjectStoreBackend stores artifacts in an S3-compatible object store
+	ObjectStoreBackend = "s3"
+	// InlineBackend stores artifacts directly in Atomix
+	InlineBackend = "inline"
+)
+
+const (
+	lockTTL          = 5 * time.Minute
+	lockPollInterval = 50 * time.Millisecond
+
+	configLockNamespace = "config"
+	blobLockNamespace   = "blob"
+)
+
+// ArtifactBackend is an abstraction for storing the content of configuration artifacts as blobs
+// addressed by their content digest
+type ArtifactBackend interface {
+	io.Closer
+
+	// Put stores the blob content under the given digest, unless such blob already exists
+	Put(ctx context.Context, digest string, data []byte) error
+
+	// Get returns the content of the blob with the given digest
+	Get(ctx context.Context, digest string) ([]byte, error)
+
+	// Delete removes the blob with the given digest
+	Delete(ctx context.Context, digest string) error
+
+	// List returns the digests of all stored blobs
+	List(ctx context.Context) ([]string, error)
+}
+
+// Checker is implemented by the artifact backends which are able to check that they can store artifacts,
+// so that the provisioner does not report being ready while it cannot accept configurations
+type Checker interface {
+	// Check returns an error if artifacts cannot currently be stored
+	Check(ctx context.Context) error
+}
+
+// legacyBackend is implemented by the artifact backends which may hold the artifacts of configurations added
+// by earlier versions, which stored them per configuration rather than as blobs addressed by their digest
+type legacyBackend interface {
+	// legacyArtifact returns the content of the given artifact of the configuration
+	legacyArtifact(kind string, configID string, artifact string) ([]byte, error)
+
+	// removeLegacyArtifact removes the given artifact of the configuration
+	removeLegacyArtifact(kind string, configID string, artifact string) error
+}
+
+// locker guards modifications of configurations and artifact blobs against concurrent modifications by
+// other provisioner instances; artifact backends may provide their own locking by implementing it
+type locker interface {
+	io.Closer
+
+	// lock acquires an exclusive lock for the given name, waiting until the lock is available or the context is done
+	lock(ctx context.Context, namespace string, name string) (func(), error)
+
+	// tryLock attempts to acquire an exclusive lock for the given name without waiting
+	tryLock(namespace string, name string) (func(), bool)
+}
+
+// Creates a locker backed by an Atomix map, for use with artifact backends which do not provide their own
+// locking; lock entries expire after lockTTL to recover from instances which crashed while holding locks
+func newAtomixLocker(client primitive.Client) (locker, error) {
+	locks, err := _map.NewBuilder[string, string](client, "onos-device-config-locks").
+		Tag("device-provisioner", "device-config-locks").
+		Codec(types.Scalar[string]()).
+		Get(context.Background())
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	hostname, _ := os.Hostname()
+	return &atomixLocker{locks: locks, owner: fmt.Sprintf("%s/%d", hostname, os.Getpid())}, nil
+}
+
+type atomixLocker struct {
+	locks _map.Map[string, string]
+	owner string
+}
+
+func (l *atomixLocker) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return errors.FromAtomix(l.locks.Close(ctx))
+}
+
+func (l *atomixLocker) lock(ctx context.Context, namespace string, name string) (func(), error) {
+	for {
+		unlock, ok, err := l.acquire(ctx, namespace, name)
+		if err != nil || ok {
+			return unlock, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errors.NewTimeout("unable to acquire %s lock for %s: %v", namespace, name, ctx.Err())
+		case <-time.After(lockPollInterval):
+		}
+	}
+}
+
+func (l *atomixLocker) tryLock(namespace string, name string) (func(), bool) {
+	unlock, ok, err := l.acquire(context.Background(), namespace, name)
+	if err != nil {
+		log.Warnf("Unable to acquire %s lock for %s: %+v", namespace, name, err)
+	}
+	return unlock, ok
+}
+
+func (l *atomixLocker) acquire(ctx context.Context, namespace string, name string) (func(), bool, error) {
+	key := namespace + "/" + name
+	entry, err := l.locks.Insert(ctx, key, l.owner, _map.WithTTL(lockTTL))
+	if err != nil {
+		if err = errors.FromAtomix(err); errors.IsAlreadyExists(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return func() {
+		_, _ = l.locks.Remove(context.Background(), key, _map.IfVersion(entry.Version))
+	}, true, nil
+}
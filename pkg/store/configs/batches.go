@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/atomix/go-sdk/pkg/primitive"
+	_map "github.com/atomix/go-sdk/pkg/primitive/map"
+	"github.com/atomix/go-sdk/pkg/types"
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// BatchPhase is the phase of a batch applying a pipeline configuration in VerifyThenCommitMode
+type BatchPhase string
+
+const (
+	// BatchVerifying indicates a batch verifying and saving the pipeline configuration on its devices
+	BatchVerifying BatchPhase = "VERIFYING"
+	// BatchCommitting indicates a batch committing the pipeline configuration, verified by all its devices
+	BatchCommitting BatchPhase = "COMMITTING"
+)
+
+// Batch tracks the progress of applying a pipeline configuration revision to a fixed set of devices in two
+// phases, so that a batch interrupted e.g. by a restart of the provisioner resumes where it left off
+type Batch struct {
+	// ID identifies the batch by the realm and the configuration revision; see BatchID
+	ID string `json:"id"`
+	// ConfigID is the reference of the configuration revision applied by the batch
+	ConfigID provisioner.ConfigID `json:"configID"`
+	// Devices are the devices of the batch, fixed when the batch is created
+	Devices []topo.ID  `json:"devices"`
+	Phase   BatchPhase `json:"phase"`
+	// Cookie is the pipeline cookie saved on all devices of the batch
+	Cookie uint64 `json:"cookie"`
+	// Verified are the devices which verified and saved the configuration
+	Verified []topo.ID `json:"verified,omitempty"`
+	// Committed are the devices on which the commit of the configuration was attempted
+	Committed []topo.ID `json:"committed,omitempty"`
+	Created   time.Time `json:"created"`
+	Updated   time.Time `json:"updated"`
+	// Version is the version of the batch entry, for use with optimistic concurrency control
+	Version uint64 `json:"-"`
+}
+
+// BatchID returns the ID of the batch applying the configuration revision to the devices of the given realm
+func BatchID(realm string, configID provisioner.ConfigID) string {
+	return realm + "/" + string(configID)
+}
+
+// BatchStore is an abstraction for tracking the batches applying pipeline configurations in two phases
+type BatchStore interface {
+	io.Closer
+
+	// Create adds a new batch; returns AlreadyExists error if there is a batch with the same ID
+	Create(ctx context.Context, batch *Batch) error
+
+	// Get returns the specified batch
+	Get(ctx context.Context, id string) (*Batch, error)
+
+	// Update updates the batch, provided it was not updated since it was read; otherwise Conflict error is returned
+	Update(ctx context.Context, batch *Batch) error
+
+	// Delete removes the specified batch
+	Delete(ctx context.Context, id string) error
+
+	// List returns all batches
+	List(ctx context.Context) ([]*Batch, error)
+}
+
+// NewAtomixBatchStore returns a new persistent store for batches
+func NewAtomixBatchStore(client primitive.Client) (BatchStore, error) {
+	batches, err := _map.NewBuilder[string, *Batch](client, "onos-device-config-batches").
+		Tag("device-provisioner", "device-config-batches").
+		Codec(types.JSON[*Batch]()).
+		Get(context.Background())
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	return &atomixBatchStore{batches: batches}, nil
+}
+
+type atomixBatchStore struct {
+	batches _map.Map[string, *Batch]
+}
+
+func (s *atomixBatchStore) Create(ctx context.Context, batch *Batch) error {
+	if batch.ID == "" {
+		return errors.NewInvalid("batch ID cannot be empty")
+	}
+	batch.Created = time.Now()
+	batch.Updated = batch.Created
+	entry, err := s.batches.Insert(ctx, batch.ID, batch)
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	batch.Version = uint64(entry.Version)
+	return nil
+}
+
+func (s *atomixBatchStore) Get(ctx context.Context, id string) (*Batch, error) {
+	entry, err := s.batches.Get(ctx, id)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	return newBatch(entry), nil
+}
+
+func (s *atomixBatchStore) Update(ctx context.Context, batch *Batch) error {
+	batch.Updated = time.Now()
+	entry, err := s.batches.Update(ctx, batch.ID, batch, _map.IfVersion(primitive.Version(batch.Version)))
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	batch.Version = uint64(entry.Version)
+	return nil
+}
+
+func (s *atomixBatchStore) Delete(ctx context.Context, id string) error {
+	if _, err := s.batches.Remove(ctx, id); err != nil {
+		return errors.FromAtomix(err)
+	}
+	return nil
+}
+
+func (s *atomixBatchStore) List(ctx context.Context) ([]*Batch, error) {
+	stream, err := s.batches.List(ctx)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	batches := make([]*Batch, 0)
+	for {
+		entry, err := stream.Next()
+		if err == io.EOF {
+			return batches, nil
+		}
+		if err != nil {
+			return nil, errors.FromAtomix(err)
+		}
+		batches = append(batches, newBatch(entry))
+	}
+}
+
+func (s *atomixBatchStore) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.batches.Close(ctx); err != nil {
+		return errors.FromAtomix(err)
+	}
+	return nil
+}
+
+func newBatch(entry *_map.Entry[string, *Batch]) *Batch {
+	batch := entry.Value
+	batch.Version = uint64(entry.Version)
+	return batch
+}
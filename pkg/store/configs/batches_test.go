@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBatchStore(t *testing.T) {
+	store, err := NewAtomixBatchStore(newTestCluster(t))
+	assert.NoError(t, err)
+	testEntryStore[*Batch](t, store, BatchID("pod-2", "fabric-leaf@2"), func(id string) *Batch {
+		return &Batch{ID: id, ConfigID: "fabric-leaf@2", Devices: []topo.ID{"leaf3"}, Phase: BatchVerifying}
+	})
+	ctx := context.TODO()
+
+	// Only one batch may apply a revision to the devices of a realm
+	id := BatchID("pod-1", "fabric-leaf@2")
+	assert.Equal(t, "pod-1/fabric-leaf@2", id)
+	batch := &Batch{ID: id, ConfigID: "fabric-leaf@2", Devices: []topo.ID{"leaf1", "leaf2"}, Phase: BatchVerifying, Cookie: 42}
+	assert.NoError(t, store.Create(ctx, batch))
+	assert.True(t, errors.IsAlreadyExists(store.Create(ctx, &Batch{ID: id})))
+
+	// The progress survives until the batch is deleted
+	batch.Verified = []topo.ID{"leaf1"}
+	assert.NoError(t, store.Update(ctx, batch))
+	resumed, err := store.Get(ctx, id)
+	assert.NoError(t, err)
+	assert.Equal(t, BatchVerifying, resumed.Phase)
+	assert.Equal(t, []topo.ID{"leaf1"}, resumed.Verified)
+	assert.Equal(t, uint64(42), resumed.Cookie)
+
+	assert.NoError(t, store.Delete(ctx, id))
+	assert.NoError(t, store.Close())
+}
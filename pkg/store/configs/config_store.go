@@ -7,11 +7,14 @@ package configs
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"github.com/atomix/go-sdk/pkg/primitive"
 	_map "github.com/atomix/go-sdk/pkg/primitive/map"
 	"github.com/atomix/go-sdk/pkg/types"
-	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/onosproject/onos-api/go/onos/provisioner"
@@ -29,15 +32,55 @@ const (
 	ChassisConfigKind = "chassis"
 )
 
+// Modes in which pipeline configurations are applied to the devices
 const (
-	artifactFormat   = "%s/%s/%s.%s" // root/kind/id.type
-	artifactDirPerms = 0755
-	artifactPerms    = 0644
+	// VerifyAndCommitMode verifies and commits the pipeline on each device on its own, resetting its forwarding state
+	VerifyAndCommitMode = "VERIFY_AND_COMMIT"
+	// ReconcileAndCommitMode commits the pipeline on each device on its own, preserving its forwarding state
+	// where possible, for hitless upgrades
+	ReconcileAndCommitMode = "RECONCILE_AND_COMMIT"
+	// VerifyThenCommitMode verifies the pipeline on all devices awaiting it before committing it on any of them,
+	// so that a pipeline rejected by any device is not committed anywhere
+	VerifyThenCommitMode = "VERIFY_THEN_COMMIT"
+)
+
+const (
+	digestPrefix = "sha256:"
+	sweepTimeout = 1 * time.Minute
+	// DefaultRevisionRetention is the default number of revisions retained for each configuration
+	DefaultRevisionRetention = 10
 )
 
 // Artifacts is a map of artifact type to artifact content
 type Artifacts map[string][]byte
 
+// Digests is a map of artifact type to the digest of the artifact content
+type Digests map[string]string
+
+// DataLossError indicates that the stored content of a configuration artifact no longer matches
+// the digest recorded when the configuration was added, e.g. due to corruption of the artifact file
+type DataLossError struct {
+	ConfigID provisioner.ConfigID
+	Artifact string
+	Digest   string
+}
+
+func (e *DataLossError) Error() string {
+	return fmt.Sprintf("artifact %s of configuration '%s' is corrupted; content does not match digest %s", e.Artifact, e.ConfigID, e.Digest)
+}
+
+// IsDataLoss returns true if the given error indicates that a configuration artifact is corrupted
+func IsDataLoss(err error) bool {
+	_, ok := err.(*DataLossError)
+	return ok
+}
+
+// Digest returns the content digest of the given artifact bytes in the form of 'sha256:<hex>'
+func Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return digestPrefix + hex.EncodeToString(sum[:])
+}
+
 // ConfigStore is an abstraction for tracking inventory of pipeline and chassis configurations
 type ConfigStore interface {
 	io.Closer
@@ -48,19 +91,65 @@ type ConfigStore interface {
 	// Delete removes the specified configuration from the inventory
 	Delete(ctx context.Context, configID provisioner.ConfigID) error
 
-	// Get returns the specified configuration record
+	// Get returns the specified configuration record; if the configuration ID is a reference to a specific
+	// revision, e.g. 'fabric-leaf@3', the returned record reflects that revision
 	Get(ctx context.Context, configID provisioner.ConfigID) (*provisioner.ConfigRecord, error)
 
-	// GetArtifacts returns the specified configuration artifacts
+	// GetArtifacts returns the specified configuration artifacts, after verifying their content against
+	// the digests recorded when the configuration was added; returns DataLossError if verification fails
 	GetArtifacts(ctx context.Context, record *provisioner.ConfigRecord) (Artifacts, error)
 
+	// AddRevision adds a new immutable revision of artifacts to an existing configuration and makes
+	// it the current revision; returns the updated history, whose version a following update can be based
+	// on. Unless the given version is 0, the revision is added only if it matches the version of the
+	// configuration history, as returned by GetHistory; otherwise Conflict error is returned
+	AddRevision(ctx context.Context, configID provisioner.ConfigID, artifacts Artifacts, version uint64) (*History, error)
+
+	// Rollback makes the specified prior revision the current revision of the configuration
+	Rollback(ctx context.Context, configID provisioner.ConfigID, revision uint64) error
+
+	// GetHistory returns the history of revisions of the specified configuration
+	GetHistory(ctx context.Context, configID provisioner.ConfigID) (*History, error)
+
+	// SetApplyMode sets the mode in which the specified pipeline configuration is applied to the devices;
+	// the mode applies to all revisions of the configuration
+	SetApplyMode(ctx context.Context, configID provisioner.ConfigID, mode string) error
+
+	// Resolve resolves the given configuration reference into a reference to a specific revision; references
+	// without revision number resolve to the current revision of the configuration
+	Resolve(ctx context.Context, ref provisioner.ConfigID) (provisioner.ConfigID, error)
+
 	// List streams all registered configuration records of the requested kind (pipeline or chassis)
 	List(ctx context.Context, kind string, ch chan *provisioner.ConfigRecord) error
+
+	// Usage returns the size of the configuration inventory
+	Usage(ctx context.Context) (*Usage, error)
+
+	// Watch streams events for configurations of the requested kind (pipeline or chassis, or all if empty),
+	// optionally replaying the existing configurations first
+	Watch(ctx context.Context, kind string, ch chan<- Event, replay bool) error
+
+	// NewUpload starts an upload of configuration artifacts in chunks; the artifact content is staged in
+	// temporary files rather than held in memory until the upload is committed
+	NewUpload() (Upload, error)
+
+	// OpenArtifact opens the content of the given artifact of the referenced configuration revision for
+	// reading in chunks and returns it along with the artifact digest; the content is verified as it is
+	// read and the reader returns DataLossError instead of io.EOF if the verification fails
+	OpenArtifact(ctx context.Context, ref provisioner.ConfigID, artifact string) (io.ReadCloser, string, error)
+
+	// SetPinnedRevisions sets the function determining the revisions of a configuration which are referenced
+	// by number; such revisions are retained beyond the revision retention until they are no longer referenced
+	SetPinnedRevisions(pinned PinnedRevisions)
 }
 
 // NewAtomixStore returns a new persistent store for configuration records whose artifacts
-// are stored in the give artifacts directory
-func NewAtomixStore(client primitive.Client, artifactsDirPath string) (ConfigStore, error) {
+// are stored using the given artifact backend, retaining up to the given number of revisions
+// of each configuration
+func NewAtomixStore(client primitive.Client, backend ArtifactBackend, retention int) (ConfigStore, error) {
+	if retention <= 0 {
+		retention = DefaultRevisionRetention
+	}
 	configs, err := _map.NewBuilder[provisioner.ConfigID, *provisioner.ConfigRecord](client, "onos-device-configs").
 		Tag("device-provisioner", "device-configs").
 		Codec(types.Proto[*provisioner.ConfigRecord](&provisioner.ConfigRecord{})).
@@ -69,42 +158,109 @@ func NewAtomixStore(client primitive.Client, artifactsDirPath string) (ConfigSto
 		return nil, errors.FromAtomix(err)
 	}
 
-	_ = os.Mkdir(fmt.Sprintf("%s/%s", artifactsDirPath, PipelineConfigKind), artifactDirPerms)
-	_ = os.Mkdir(fmt.Sprintf("%s/%s", artifactsDirPath, ChassisConfigKind), artifactDirPerms)
+	revisions, err := _map.NewBuilder[provisioner.ConfigID, *History](client, "onos-device-config-revisions").
+		Tag("device-provisioner", "device-config-revisions").
+		Codec(types.JSON[*History]()).
+		Get(context.Background())
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+
+	refs, err := _map.NewBuilder[string, int](client, "onos-device-config-blobs").
+		Tag("device-provisioner", "device-config-blobs").
+		Codec(types.Scalar[int]()).
+		Get(context.Background())
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+
+	locks, ok := backend.(locker)
+	if !ok {
+		if locks, err = newAtomixLocker(client); err != nil {
+			return nil, err
+		}
+	}
 
 	store := &atomixStore{
-		configs: configs,
-		path:    artifactsDirPath,
+		configs:   configs,
+		revisions: revisions,
+		refs:      refs,
+		backend:   backend,
+		locks:     locks,
+		retention: retention,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sweepTimeout)
+	defer cancel()
+	if err = store.migrateArtifacts(ctx); err != nil {
+		return nil, err
 	}
+	store.sweep(ctx)
 	return store, nil
 }
 
-// atomixStore is the object implementation of the ConfigStore
+// atomixStore is the object implementation of the ConfigStore; artifacts are stored as content-addressed
+// blobs, which are shared by all configurations with identical artifact content and reference counted
 type atomixStore struct {
-	configs _map.Map[provisioner.ConfigID, *provisioner.ConfigRecord]
-	path    string
+	configs   _map.Map[provisioner.ConfigID, *provisioner.ConfigRecord]
+	revisions _map.Map[provisioner.ConfigID, *History]
+	refs      _map.Map[string, int]
+	backend   ArtifactBackend
+	locks     locker
+	retention int
+	mu        sync.RWMutex
+	pinned    PinnedRevisions
 }
 
 // Add registers a new configuration in the inventory
 func (s *atomixStore) Add(ctx context.Context, record *provisioner.ConfigRecord, artifacts Artifacts) error {
-	if record == nil || len(artifacts) == 0 {
+	return s.add(ctx, record, "", newBlobs(artifacts))
+}
+
+// Registers a new configuration in the inventory along with its apply mode, storing its artifacts from the
+// given blobs; the empty mode stands for VerifyAndCommitMode
+func (s *atomixStore) add(ctx context.Context, record *provisioner.ConfigRecord, applyMode string, blobs map[string]*blob) error {
+	if record == nil || len(blobs) == 0 {
 		return errors.NewInvalid("Record or Artifacts cannot be empty")
 	}
 	if record.ConfigID == "" {
 		return errors.NewInvalid("ConfigID cannot be empty")
 	}
+	if strings.Contains(string(record.ConfigID), RevisionSeparator) {
+		return errors.NewInvalid("ConfigID cannot contain '%s'", RevisionSeparator)
+	}
 	if record.Kind != PipelineConfigKind && record.Kind != ChassisConfigKind {
 		return errors.NewInvalid("Kind must be 'pipeline' or 'chassis'")
 	}
+	if err := validateApplyMode(applyMode); err != nil {
+		return err
+	}
+	if applyMode != "" && record.Kind != PipelineConfigKind {
+		return errors.NewInvalid("apply mode can be set only for %s configurations", PipelineConfigKind)
+	}
 
 	log.Infof("Adding configuration '%s'", record.ConfigID)
-	if err := s.saveArtifacts(record, artifacts); err != nil {
-		_, _ = s.configs.Remove(ctx, record.ConfigID)
+	unlock, err := s.locks.lock(ctx, configLockNamespace, string(record.ConfigID))
+	if err != nil {
+		log.Warnf("Failed to add configuration %+v: %v", record, err)
 		return err
 	}
+	defer unlock()
 
-	_, err := s.configs.Insert(ctx, record.ConfigID, record)
+	digests, err := s.saveArtifacts(ctx, record, blobs)
 	if err != nil {
+		return err
+	}
+
+	history := &History{Current: 1, Revisions: []*Revision{{Number: 1, Digests: digests, Created: time.Now(), Sizes: blobSizes(blobs)}},
+		ApplyMode: applyMode}
+	if _, err = s.revisions.Insert(ctx, record.ConfigID, history); err == nil {
+		if _, err = s.configs.Insert(ctx, record.ConfigID, record); err != nil {
+			_, _ = s.revisions.Remove(ctx, record.ConfigID)
+		}
+	}
+	if err != nil {
+		s.releaseArtifacts(ctx, digests)
 		err = errors.FromAtomix(err)
 		if !errors.IsAlreadyExists(err) {
 			log.Errorf("Failed to add configuration %+v: %v", record, err)
@@ -121,8 +277,18 @@ func (s *atomixStore) Delete(ctx context.Context, configID provisioner.ConfigID)
 	if configID == "" {
 		return errors.NewInvalid("ConfigID cannot be empty")
 	}
+	if strings.Contains(string(configID), RevisionSeparator) {
+		return errors.NewInvalid("Individual revisions cannot be deleted")
+	}
 
 	log.Infof("Deleting configuration '%s'", configID)
+	unlock, err := s.locks.lock(ctx, configLockNamespace, string(configID))
+	if err != nil {
+		log.Warnf("Failed to delete configuration '%s': %v", configID, err)
+		return err
+	}
+	defer unlock()
+
 	entry, err := s.configs.Remove(ctx, configID)
 	if err != nil {
 		err = errors.FromAtomix(err)
@@ -133,12 +299,16 @@ func (s *atomixStore) Delete(ctx context.Context, configID provisioner.ConfigID)
 		}
 		return err
 	}
-	s.deleteArtifacts(entry.Value)
+	s.deleteArtifacts(ctx, entry.Value)
 	return nil
 }
 
 // Get returns the specified configuration
-func (s *atomixStore) Get(ctx context.Context, configID provisioner.ConfigID) (*provisioner.ConfigRecord, error) {
+func (s *atomixStore) Get(ctx context.Context, ref provisioner.ConfigID) (*provisioner.ConfigRecord, error) {
+	configID, revision, err := ParseRef(ref)
+	if err != nil {
+		return nil, err
+	}
 	if configID == "" {
 		return nil, errors.NewInvalid("ConfigID cannot be empty")
 	}
@@ -154,12 +324,25 @@ func (s *atomixStore) Get(ctx context.Context, configID provisioner.ConfigID) (*
 		return nil, err
 	}
 	record := entry.Value
-	return record, nil
+	if revision == 0 {
+		return record, nil
+	}
+
+	history, err := s.GetHistory(ctx, configID)
+	if err != nil {
+		return nil, err
+	}
+	r := history.Revision(revision)
+	if r == nil {
+		log.Warnf("Failed to get configuration '%s': revision %d not found", configID, revision)
+		return nil, errors.NewNotFound("revision %d of configuration '%s' not found", revision, configID)
+	}
+	return &provisioner.ConfigRecord{ConfigID: Ref(configID, revision), Kind: record.Kind, Artifacts: r.Digests.types()}, nil
 }
 
 // GetArtifacts returns the specified configuration artifacts
 func (s *atomixStore) GetArtifacts(ctx context.Context, record *provisioner.ConfigRecord) (Artifacts, error) {
-	return s.loadArtifacts(record)
+	return s.loadArtifacts(ctx, record)
 }
 
 // List streams all registered configurations of the requested kind (pipeline or chassis)
@@ -189,55 +372,310 @@ func (s *atomixStore) List(ctx context.Context, kind string, ch chan *provisione
 func (s *atomixStore) Close() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	err := s.configs.Close(ctx)
-	if err != nil {
-		return errors.FromAtomix(err)
+	for _, p := range []primitive.Primitive{s.configs, s.revisions, s.refs} {
+		if err := p.Close(ctx); err != nil {
+			return errors.FromAtomix(err)
+		}
 	}
-	return nil
+	if err := s.locks.Close(); err != nil {
+		return err
+	}
+	return s.backend.Close()
 
 }
 
-// Utilities for storing and reading configuration artifacts to/from files
-
-// Generates artifact file path from the record and the specified artifact type
-func (s *atomixStore) artifactPath(record *provisioner.ConfigRecord, artifactType string) string {
-	return fmt.Sprintf(artifactFormat, s.path, record.Kind, record.ConfigID, artifactType)
-}
+// Utilities for storing and reading configuration artifacts to/from content-addressed blobs
 
-// Saves all artifact bytes into files and updates the record of artifacts
-func (s *atomixStore) saveArtifacts(record *provisioner.ConfigRecord, artifacts Artifacts) error {
-	// TODO: add lock file
-	record.Artifacts = make([]string, 0, len(artifacts))
-	for artifact, data := range artifacts {
-		path := s.artifactPath(record, artifact)
-		if err := os.WriteFile(path, data, artifactPerms); err != nil {
+// Saves all artifact bytes into blobs, references them and updates the record of artifacts;
+// returns the digests of the saved artifacts
+func (s *atomixStore) saveArtifacts(ctx context.Context, record *provisioner.ConfigRecord, blobs map[string]*blob) (Digests, error) {
+	record.Artifacts = make([]string, 0, len(blobs))
+	digests := make(Digests, len(blobs))
+	for artifact, b := range blobs {
+		if err := s.saveBlob(ctx, b); err != nil {
 			log.Errorf("Unable to write artifact: %+v", err)
-			s.deleteArtifacts(record)
-			return err
+			s.releaseArtifacts(ctx, digests)
+			return nil, err
 		}
+		digests[artifact] = b.digest
 		record.Artifacts = append(record.Artifacts, artifact)
 	}
+	return digests, nil
+}
+
+// References the specified artifact blob and stores it unless it already exists
+func (s *atomixStore) saveBlob(ctx context.Context, b *blob) error {
+	unlock, err := s.locks.lock(ctx, blobLockNamespace, b.digest)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err = s.reference(ctx, b.digest); err != nil {
+		return err
+	}
+	if err = s.putBlob(ctx, b); err != nil {
+		_, _ = s.release(ctx, b.digest)
+		return err
+	}
 	return nil
 }
 
-// Loads artifact files using the configuration record into memory as artifact bytes
-func (s *atomixStore) loadArtifacts(record *provisioner.ConfigRecord) (Artifacts, error) {
-	artifacts := make(map[string][]byte, len(record.Artifacts))
-	for _, artifact := range record.Artifacts {
-		path := s.artifactPath(record, artifact)
-		data, err := os.ReadFile(path)
+// Returns the specified revision of the configuration; revision 0 denotes the current revision
+func (s *atomixStore) getRevision(ctx context.Context, configID provisioner.ConfigID, revision uint64) (*Revision, error) {
+	history, err := s.GetHistory(ctx, configID)
+	if err != nil {
+		log.Errorf("Unable to get artifact digests for '%s': %+v", configID, err)
+		return nil, err
+	}
+	if revision == 0 {
+		revision = history.Current
+	}
+	r := history.Revision(revision)
+	if r == nil {
+		log.Errorf("Unable to find revision %d of '%s'", revision, configID)
+		return nil, errors.NewNotFound("revision %d of configuration '%s' not found", revision, configID)
+	}
+	return r, nil
+}
+
+// Loads artifact blobs of the configuration revision referenced by the record into memory as artifact bytes
+func (s *atomixStore) loadArtifacts(ctx context.Context, record *provisioner.ConfigRecord) (Artifacts, error) {
+	configID, revision, err := ParseRef(record.ConfigID)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.getRevision(ctx, configID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts := make(map[string][]byte, len(r.Digests))
+	for artifact, digest := range r.Digests {
+		data, err := s.backend.Get(ctx, digest)
 		if err != nil {
 			log.Errorf("Unable to load artifact: %+v", err)
 			return nil, err
 		}
+		if Digest(data) != digest {
+			err = &DataLossError{ConfigID: record.ConfigID, Artifact: artifact, Digest: digest}
+			log.Errorf("Unable to verify artifact: %+v", err)
+			return nil, err
+		}
 		artifacts[artifact] = data
 	}
 	return artifacts, nil
 }
 
-// Deletes all artifacts associated with the specified configuration record
-func (s *atomixStore) deleteArtifacts(record *provisioner.ConfigRecord) {
+// Releases artifacts of all revisions of the specified configuration record
+func (s *atomixStore) deleteArtifacts(ctx context.Context, record *provisioner.ConfigRecord) {
+	entry, err := s.revisions.Remove(ctx, record.ConfigID)
+	if err != nil {
+		log.Warnf("Unable to remove revisions of '%s': %+v", record.ConfigID, errors.FromAtomix(err))
+		return
+	}
+	for _, revision := range entry.Value.Revisions {
+		s.releaseArtifacts(ctx, revision.Digests)
+	}
+}
+
+// Releases the specified artifact blobs, deleting the blobs which are no longer referenced
+func (s *atomixStore) releaseArtifacts(ctx context.Context, digests Digests) {
+	for _, digest := range digests {
+		if err := s.releaseBlob(ctx, digest); err != nil {
+			log.Warnf("Unable to release artifact blob %s: %+v", digest, err)
+		}
+	}
+}
+
+// Releases the specified artifact blob, deleting the blob if it is no longer referenced
+func (s *atomixStore) releaseBlob(ctx context.Context, digest string) error {
+	unlock, err := s.locks.lock(ctx, blobLockNamespace, digest)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	unreferenced, err := s.release(ctx, digest)
+	if err != nil {
+		return err
+	}
+	if unreferenced {
+		if err = s.backend.Delete(ctx, digest); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Increments the reference count of the specified artifact blob
+func (s *atomixStore) reference(ctx context.Context, digest string) error {
+	for {
+		entry, err := s.refs.Get(ctx, digest)
+		if err != nil {
+			if err = errors.FromAtomix(err); !errors.IsNotFound(err) {
+				return err
+			}
+			_, err = s.refs.Insert(ctx, digest, 1)
+			if err = errors.FromAtomix(err); err == nil || !errors.IsAlreadyExists(err) {
+				return err
+			}
+			continue
+		}
+		_, err = s.refs.Update(ctx, digest, entry.Value+1, _map.IfVersion(entry.Version))
+		if err = errors.FromAtomix(err); err == nil || !errors.IsConflict(err) {
+			return err
+		}
+	}
+}
+
+// Decrements the reference count of the specified artifact blob; returns true if the blob is no longer referenced
+func (s *atomixStore) release(ctx context.Context, digest string) (bool, error) {
+	for {
+		entry, err := s.refs.Get(ctx, digest)
+		if err != nil {
+			if err = errors.FromAtomix(err); errors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		if entry.Value <= 1 {
+			_, err = s.refs.Remove(ctx, digest, _map.IfVersion(entry.Version))
+		} else {
+			_, err = s.refs.Update(ctx, digest, entry.Value-1, _map.IfVersion(entry.Version))
+		}
+		if err = errors.FromAtomix(err); err == nil || !errors.IsConflict(err) {
+			return err == nil && entry.Value <= 1, err
+		}
+	}
+}
+
+// Sweeps away any debris left behind by provisioner instances that crashed while modifying the inventory:
+// revisions of configurations whose records were never added and artifact blobs which are not referenced
+// by any configuration; items locked by other instances are presumed to be in use and are skipped
+func (s *atomixStore) sweep(ctx context.Context) {
+	stream, err := s.revisions.List(ctx)
+	if err != nil {
+		log.Warnf("Unable to list configuration revisions: %+v", errors.FromAtomix(err))
+		return
+	}
+	for {
+		entry, err := stream.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Warnf("Unable to list configuration revisions: %+v", errors.FromAtomix(err))
+			return
+		}
+		s.sweepRevisions(ctx, entry.Key)
+	}
+
+	digests, err := s.backend.List(ctx)
+	if err != nil {
+		log.Warnf("Unable to list artifact blobs: %+v", err)
+		return
+	}
+	for _, digest := range digests {
+		s.sweepBlob(ctx, digest)
+	}
+}
+
+// Releases the revisions of the specified configuration if there is no record for it
+func (s *atomixStore) sweepRevisions(ctx context.Context, configID provisioner.ConfigID) {
+	unlock, ok := s.locks.tryLock(configLockNamespace, string(configID))
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	if _, err := s.configs.Get(ctx, configID); !errors.IsNotFound(errors.FromAtomix(err)) {
+		return
+	}
+	log.Infof("Sweeping revisions of orphaned configuration '%s'", configID)
+	s.deleteArtifacts(ctx, &provisioner.ConfigRecord{ConfigID: configID})
+}
+
+// Deletes the specified artifact blob if it is not referenced
+func (s *atomixStore) sweepBlob(ctx context.Context, digest string) {
+	unlock, ok := s.locks.tryLock(blobLockNamespace, digest)
+	if !ok {
+		return
+	}
+	defer unlock()
+
+	if _, err := s.refs.Get(ctx, digest); !errors.IsNotFound(errors.FromAtomix(err)) {
+		return
+	}
+	log.Infof("Sweeping orphaned artifact blob %s", digest)
+	_ = s.backend.Delete(ctx, digest)
+}
+
+// Moves the artifacts of the configurations added by versions which stored them per configuration into blobs,
+// making them the first and current revision of each configuration; the configurations which already have
+// revisions are left alone, so the migration runs only once
+func (s *atomixStore) migrateArtifacts(ctx context.Context) error {
+	legacy, ok := s.backend.(legacyBackend)
+	if !ok {
+		return nil
+	}
+	stream, err := s.configs.List(ctx)
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	for {
+		entry, err := stream.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.FromAtomix(err)
+		}
+		if err = s.migrateConfigArtifacts(ctx, legacy, entry.Value); err != nil {
+			return err
+		}
+	}
+}
+
+// Moves the artifacts of the configuration into blobs unless it already has revisions
+func (s *atomixStore) migrateConfigArtifacts(ctx context.Context, legacy legacyBackend, record *provisioner.ConfigRecord) error {
+	unlock, err := s.locks.lock(ctx, configLockNamespace, string(record.ConfigID))
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, err = s.revisions.Get(ctx, record.ConfigID); !errors.IsNotFound(errors.FromAtomix(err)) {
+		return errors.FromAtomix(err)
+	}
+	artifacts := make(Artifacts, len(record.Artifacts))
 	for _, artifact := range record.Artifacts {
-		_ = os.Remove(s.artifactPath(record, artifact))
+		data, err := legacy.legacyArtifact(record.Kind, string(record.ConfigID), artifact)
+		if err != nil {
+			log.Warnf("Unable to migrate artifact %s of configuration '%s': %v", artifact, record.ConfigID, err)
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		artifacts[artifact] = data
+	}
+
+	log.Infof("Migrating artifacts of configuration '%s' to revision 1", record.ConfigID)
+	blobs := newBlobs(artifacts)
+	digests, err := s.saveArtifacts(ctx, record, blobs)
+	if err != nil {
+		return err
+	}
+	history := &History{Current: 1, Revisions: []*Revision{{Number: 1, Digests: digests, Created: time.Now(), Sizes: blobSizes(blobs)}}}
+	if _, err = s.revisions.Insert(ctx, record.ConfigID, history); err != nil {
+		s.releaseArtifacts(ctx, digests)
+		return errors.FromAtomix(err)
 	}
+	for artifact := range artifacts {
+		if err = legacy.removeLegacyArtifact(record.Kind, string(record.ConfigID), artifact); err != nil {
+			log.Warnf("Unable to remove migrated artifact %s of configuration '%s': %v", artifact, record.ConfigID, err)
+		}
+	}
+	return nil
 }
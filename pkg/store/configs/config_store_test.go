@@ -6,10 +6,16 @@ package configs
 
 import (
 	"context"
+	"fmt"
+	_map "github.com/atomix/go-sdk/pkg/primitive/map"
 	"github.com/atomix/go-sdk/pkg/test"
+	"github.com/atomix/go-sdk/pkg/types"
 	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"io"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -25,7 +31,9 @@ func TestConfigStore(t *testing.T) {
 	_ = os.RemoveAll(artifactsDir)
 	assert.NoError(t, os.Mkdir(artifactsDir, 0755))
 
-	store, err := NewAtomixStore(cluster, artifactsDir)
+	backend, err := NewDirBackend(artifactsDir)
+	assert.NoError(t, err)
+	store, err := NewAtomixStore(cluster, backend, DefaultRevisionRetention)
 	assert.NoError(t, err)
 
 	ctx := context.TODO()
@@ -54,6 +62,9 @@ func TestConfigStore(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, cr1.Artifacts, 1)
 
+	// The identical p4info artifact should be stored only once
+	assert.Len(t, blobs(t), 4)
+
 	// List all configurations; there should be 3
 	ch = make(chan *provisioner.ConfigRecord, depth)
 	assert.NoError(t, store.List(ctx, "", ch))
@@ -82,11 +93,25 @@ func TestConfigStore(t *testing.T) {
 	// Delete one of the pipeline configurations
 	assert.NoError(t, store.Delete(ctx, "fp_foo_spine"))
 
+	// The shared p4info artifact should remain, while the spine binary should be gone
+	assert.Len(t, blobs(t), 3)
+	ca, err = store.GetArtifacts(ctx, pr2)
+	assert.NoError(t, err)
+	assert.Equal(t, pa2["p4info"], ca["p4info"])
+
 	// List all pipeline configurations; there should be 1
 	ch = make(chan *provisioner.ConfigRecord, depth)
 	assert.NoError(t, store.List(ctx, PipelineConfigKind, ch))
 	assert.Len(t, read(ch), 1)
 
+	// The usage accounts for the shared p4info artifact only once
+	usage, err := store.Usage(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{PipelineConfigKind: 1, ChassisConfigKind: 1}, usage.Configs)
+	assert.Equal(t, 2, usage.Revisions)
+	assert.Equal(t, 3, usage.Artifacts)
+	assert.Equal(t, int64(len(pa2["p4info"])+len(pa2["bin"])+len(ca1["json"])), usage.ArtifactBytes)
+
 	// Test some bad things
 
 	// Try to get a deleted item
@@ -101,6 +126,7 @@ func TestConfigStore(t *testing.T) {
 
 	// Try to add an item that already exists
 	assert.Error(t, store.Add(ctx, pr2, pa2))
+	assert.Len(t, blobs(t), 3)
 
 	// Try to add item with bad record info
 	assert.Error(t, store.Add(ctx, nil, nil))
@@ -108,9 +134,271 @@ func TestConfigStore(t *testing.T) {
 	assert.Error(t, store.Add(ctx, &provisioner.ConfigRecord{}, pa1))
 	assert.Error(t, store.Add(ctx, &provisioner.ConfigRecord{ConfigID: ""}, pa1))
 
+	// Corrupt one of the artifacts and make sure it is detected
+	binPath := artifactsDir + "/" + blobDir + "/" + strings.TrimPrefix(Digest(pa2["bin"]), digestPrefix)
+	assert.NoError(t, os.WriteFile(binPath, []byte("different device binarx"), 0644))
+	_, err = store.GetArtifacts(ctx, pr2)
+	assert.Error(t, err)
+	assert.True(t, IsDataLoss(err))
+
+	assert.NoError(t, store.Close())
+}
+
+func TestSweep(t *testing.T) {
+	cluster := test.NewClient()
+	defer cluster.Close()
+
+	_ = os.RemoveAll(artifactsDir)
+	assert.NoError(t, os.MkdirAll(artifactsDir+"/"+blobDir, 0755))
+
+	// Leave behind an incomplete write and an unreferenced blob
+	orphan := strings.TrimPrefix(Digest([]byte("orphan")), digestPrefix)
+	assert.NoError(t, os.WriteFile(artifactsDir+"/"+blobDir+"/"+orphan+tempSuffix+"123", []byte("orph"), 0644))
+	assert.NoError(t, os.WriteFile(artifactsDir+"/"+blobDir+"/"+orphan, []byte("orphan"), 0644))
+
+	backend, err := NewDirBackend(artifactsDir)
+	assert.NoError(t, err)
+	store, err := NewAtomixStore(cluster, backend, DefaultRevisionRetention)
+	assert.NoError(t, err)
+	assert.Len(t, blobs(t), 0)
+
+	ctx := context.TODO()
+	record := &provisioner.ConfigRecord{ConfigID: "ch_foo_spine", Kind: ChassisConfigKind}
+	assert.NoError(t, store.Add(ctx, record, Artifacts{"chassis": []byte("chassis content")}))
+	assert.Len(t, blobs(t), 1)
+
+	// Referenced blobs must survive a sweep
+	store.(*atomixStore).sweep(ctx)
+	assert.Len(t, blobs(t), 1)
+	ca, err := store.GetArtifacts(ctx, record)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("chassis content"), ca["chassis"])
+
+	assert.NoError(t, store.Close())
+}
+
+func TestRevisions(t *testing.T) {
+	cluster := test.NewClient()
+	defer cluster.Close()
+
+	_ = os.RemoveAll(artifactsDir)
+	backend, err := NewDirBackend(artifactsDir)
+	assert.NoError(t, err)
+	store, err := NewAtomixStore(cluster, backend, DefaultRevisionRetention)
+	assert.NoError(t, err)
+
+	ctx := context.TODO()
+	record := &provisioner.ConfigRecord{ConfigID: "fp_foo_leaf", Kind: PipelineConfigKind}
+	assert.NoError(t, store.Add(ctx, record, Artifacts{"p4info": []byte("p4info v1"), "bin": []byte("bin v1")}))
+
+	ref, err := store.Resolve(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	assert.Equal(t, provisioner.ConfigID("fp_foo_leaf@1"), ref)
+
+	// Add a new revision; it should become current
+	history, err := store.GetHistory(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	updated, err := store.AddRevision(ctx, "fp_foo_leaf", Artifacts{"p4info": []byte("p4info v1"), "bin": []byte("bin v2")}, history.Version)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), updated.Current)
+	assert.NotEqual(t, history.Version, updated.Version)
+	assert.Len(t, blobs(t), 3)
+
+	// Adding a revision based on a stale version should fail
+	_, err = store.AddRevision(ctx, "fp_foo_leaf", Artifacts{"bin": []byte("bin v3")}, history.Version)
+	assert.True(t, errors.IsConflict(err))
+	assert.Len(t, blobs(t), 3)
+
+	ref, err = store.Resolve(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	assert.Equal(t, provisioner.ConfigID("fp_foo_leaf@2"), ref)
+
+	cr, err := store.Get(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	ca, err := store.GetArtifacts(ctx, cr)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("bin v2"), ca["bin"])
+
+	// Prior revision should remain available when pinned
+	cr, err = store.Get(ctx, "fp_foo_leaf@1")
+	assert.NoError(t, err)
+	assert.Equal(t, provisioner.ConfigID("fp_foo_leaf@1"), cr.ConfigID)
+	assert.Equal(t, PipelineConfigKind, cr.Kind)
+	ca, err = store.GetArtifacts(ctx, cr)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("bin v1"), ca["bin"])
+
+	// Roll back to the first revision
+	assert.NoError(t, store.Rollback(ctx, "fp_foo_leaf", 1))
+	ref, err = store.Resolve(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	assert.Equal(t, provisioner.ConfigID("fp_foo_leaf@1"), ref)
+
+	history, err = store.GetHistory(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	assert.Len(t, history.Revisions, 2)
+	assert.Equal(t, uint64(1), history.Current)
+
+	// The apply mode applies to all revisions of a pipeline configuration
+	assert.NoError(t, store.SetApplyMode(ctx, "fp_foo_leaf", VerifyThenCommitMode))
+	history, err = store.GetHistory(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	assert.Equal(t, VerifyThenCommitMode, history.ApplyMode)
+	assert.Equal(t, uint64(1), history.Current)
+	assert.True(t, errors.IsInvalid(store.SetApplyMode(ctx, "fp_foo_leaf", "COMMIT")))
+	assert.True(t, errors.IsNotFound(store.SetApplyMode(ctx, "fp_foo_spine", VerifyThenCommitMode)))
+
+	// Test some bad things
+	assert.Error(t, store.Rollback(ctx, "fp_foo_leaf", 3))
+	_, err = store.Get(ctx, "fp_foo_leaf@3")
+	assert.Error(t, err)
+	_, err = store.Resolve(ctx, "fp_foo_leaf@x")
+	assert.Error(t, err)
+	_, err = store.AddRevision(ctx, "fp_foo_spine", Artifacts{"bin": []byte("bin")}, 0)
+	assert.Error(t, err)
+	assert.Error(t, store.Add(ctx, &provisioner.ConfigRecord{ConfigID: "fp@1", Kind: PipelineConfigKind}, Artifacts{"bin": []byte("bin")}))
+	assert.Error(t, store.Delete(ctx, "fp_foo_leaf@1"))
+
+	// Deleting the configuration should release artifacts of all revisions
+	assert.NoError(t, store.Delete(ctx, "fp_foo_leaf"))
+	assert.Len(t, blobs(t), 0)
+
+	assert.NoError(t, store.Close())
+}
+
+func TestRevisionRetention(t *testing.T) {
+	cluster := test.NewClient()
+	defer cluster.Close()
+
+	_ = os.RemoveAll(artifactsDir)
+	backend, err := NewDirBackend(artifactsDir)
+	assert.NoError(t, err)
+	store, err := NewAtomixStore(cluster, backend, 2)
+	assert.NoError(t, err)
+
+	ctx := context.TODO()
+	record := &provisioner.ConfigRecord{ConfigID: "fp_foo_leaf", Kind: PipelineConfigKind}
+	assert.NoError(t, store.Add(ctx, record, Artifacts{"p4info": []byte("p4info"), "bin": []byte("bin v1")}))
+	_, err = store.AddRevision(ctx, "fp_foo_leaf", Artifacts{"p4info": []byte("p4info"), "bin": []byte("bin v2")}, 0)
+	assert.NoError(t, err)
+	assert.Len(t, blobs(t), 3)
+
+	// The oldest revision is pruned and only its unshared artifacts are deleted
+	_, err = store.AddRevision(ctx, "fp_foo_leaf", Artifacts{"p4info": []byte("p4info"), "bin": []byte("bin v3")}, 0)
+	assert.NoError(t, err)
+	history, err := store.GetHistory(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	assert.Len(t, history.Revisions, 2)
+	assert.Nil(t, history.Revision(1))
+	assert.Len(t, blobs(t), 3)
+	_, err = store.Get(ctx, "fp_foo_leaf@1")
+	assert.True(t, errors.IsNotFound(err))
+
 	assert.NoError(t, store.Close())
 }
 
+func TestPinnedRevisionRetention(t *testing.T) {
+	cluster := test.NewClient()
+	defer cluster.Close()
+
+	_ = os.RemoveAll(artifactsDir)
+	backend, err := NewDirBackend(artifactsDir)
+	assert.NoError(t, err)
+	retention := 2
+	store, err := NewAtomixStore(cluster, backend, retention)
+	assert.NoError(t, err)
+
+	// A device is pinned to the first revision
+	store.SetPinnedRevisions(func(ctx context.Context, configID provisioner.ConfigID) (map[uint64]bool, error) {
+		return map[uint64]bool{1: true}, nil
+	})
+	ctx := context.TODO()
+	record := &provisioner.ConfigRecord{ConfigID: "fp_foo_leaf", Kind: PipelineConfigKind}
+	assert.NoError(t, store.Add(ctx, record, Artifacts{"p4info": []byte("p4info"), "bin": []byte("bin v1")}))
+	for i := 2; i <= retention+2; i++ {
+		_, err = store.AddRevision(ctx, "fp_foo_leaf", Artifacts{"p4info": []byte("p4info"), "bin": []byte(fmt.Sprintf("bin v%d", i))}, 0)
+		assert.NoError(t, err)
+	}
+
+	// The pinned revision is retained besides the latest revisions, while the unpinned ones are pruned
+	history, err := store.GetHistory(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	assert.Len(t, history.Revisions, retention+1)
+	assert.Nil(t, history.Revision(2))
+	ref, err := store.Resolve(ctx, "fp_foo_leaf@1")
+	assert.NoError(t, err)
+	assert.Equal(t, provisioner.ConfigID("fp_foo_leaf@1"), ref)
+	cr, err := store.Get(ctx, ref)
+	assert.NoError(t, err)
+	ca, err := store.GetArtifacts(ctx, cr)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("bin v1"), ca["bin"])
+
+	// Revisions are not added while the pinned revisions cannot be determined
+	store.SetPinnedRevisions(func(ctx context.Context, configID provisioner.ConfigID) (map[uint64]bool, error) {
+		return nil, errors.NewUnavailable("topo unavailable")
+	})
+	_, err = store.AddRevision(ctx, "fp_foo_leaf", Artifacts{"p4info": []byte("p4info"), "bin": []byte("bin v9")}, 0)
+	assert.True(t, errors.IsUnavailable(err))
+
+	assert.NoError(t, store.Close())
+}
+
+func TestMigrateArtifacts(t *testing.T) {
+	cluster := test.NewClient()
+	defer cluster.Close()
+
+	// Leave behind a configuration with its artifacts stored the way earlier versions stored them
+	_ = os.RemoveAll(artifactsDir)
+	assert.NoError(t, os.MkdirAll(artifactsDir+"/"+PipelineConfigKind, 0755))
+	assert.NoError(t, os.WriteFile(artifactsDir+"/pipeline/fp_foo_leaf.p4info", []byte("p4info"), 0644))
+	assert.NoError(t, os.WriteFile(artifactsDir+"/pipeline/fp_foo_leaf.bin", []byte("bin"), 0644))
+	ctx := context.TODO()
+	configs, err := _map.NewBuilder[provisioner.ConfigID, *provisioner.ConfigRecord](cluster, "onos-device-configs").
+		Codec(types.Proto[*provisioner.ConfigRecord](&provisioner.ConfigRecord{})).
+		Get(ctx)
+	assert.NoError(t, err)
+	record := &provisioner.ConfigRecord{ConfigID: "fp_foo_leaf", Kind: PipelineConfigKind, Artifacts: []string{"p4info", "bin"}}
+	_, err = configs.Put(ctx, record.ConfigID, record)
+	assert.NoError(t, err)
+
+	backend, err := NewDirBackend(artifactsDir)
+	assert.NoError(t, err)
+	store, err := NewAtomixStore(cluster, backend, DefaultRevisionRetention)
+	assert.NoError(t, err)
+
+	// The artifacts become the first revision and the old files are gone
+	ref, err := store.Resolve(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	assert.Equal(t, provisioner.ConfigID("fp_foo_leaf@1"), ref)
+	cr, err := store.Get(ctx, ref)
+	assert.NoError(t, err)
+	ca, err := store.GetArtifacts(ctx, cr)
+	assert.NoError(t, err)
+	assert.Equal(t, Artifacts{"p4info": []byte("p4info"), "bin": []byte("bin")}, ca)
+	assert.Len(t, blobs(t), 2)
+	_, err = os.Stat(artifactsDir + "/" + PipelineConfigKind)
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, store.Close())
+
+	// The migration runs only once
+	backend, err = NewDirBackend(artifactsDir)
+	assert.NoError(t, err)
+	store, err = NewAtomixStore(cluster, backend, DefaultRevisionRetention)
+	assert.NoError(t, err)
+	history, err := store.GetHistory(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	assert.Len(t, history.Revisions, 1)
+	assert.Len(t, blobs(t), 2)
+	assert.NoError(t, store.Close())
+}
+
+func blobs(t *testing.T) []os.DirEntry {
+	entries, err := os.ReadDir(artifactsDir + "/" + blobDir)
+	assert.NoError(t, err)
+	return entries
+}
+
 func read(ch chan *provisioner.ConfigRecord) []*provisioner.ConfigRecord {
 	records := make([]*provisioner.ConfigRecord, 0, 2)
 	for record := range ch {
@@ -118,3 +406,129 @@ func read(ch chan *provisioner.ConfigRecord) []*provisioner.ConfigRecord {
 	}
 	return records
 }
+
+func TestUpload(t *testing.T) {
+	cluster := test.NewClient()
+	defer cluster.Close()
+
+	_ = os.RemoveAll(artifactsDir)
+	backend, err := NewDirBackend(artifactsDir)
+	assert.NoError(t, err)
+	store, err := NewAtomixStore(cluster, backend, DefaultRevisionRetention)
+	assert.NoError(t, err)
+
+	ctx := context.TODO()
+	record := &provisioner.ConfigRecord{ConfigID: "fp_foo_leaf", Kind: PipelineConfigKind}
+
+	// An upload not matching the expected digests should be rejected
+	upload, err := store.NewUpload()
+	assert.NoError(t, err)
+	assert.NoError(t, upload.Write("bin", []byte("device ")))
+	assert.NoError(t, upload.Write("bin", []byte("binary")))
+	err = upload.Commit(ctx, record, "", Digests{"bin": Digest([]byte("device binarx"))})
+	assert.True(t, IsDataLoss(err))
+	assert.Error(t, upload.Write("bin", []byte("more")))
+	assert.Len(t, blobs(t), 0)
+
+	// So should an upload with an unsupported apply mode, adding nothing
+	upload, err = store.NewUpload()
+	assert.NoError(t, err)
+	assert.NoError(t, upload.Write("bin", []byte("device binary")))
+	assert.True(t, errors.IsInvalid(upload.Commit(ctx, record, "COMMIT", nil)))
+	_, err = store.Get(ctx, "fp_foo_leaf")
+	assert.True(t, errors.IsNotFound(err))
+	assert.Len(t, blobs(t), 0)
+
+	upload, err = store.NewUpload()
+	assert.NoError(t, err)
+	assert.NoError(t, upload.Write("p4info", []byte("p4info")))
+	assert.NoError(t, upload.Write("bin", []byte("device ")))
+	assert.NoError(t, upload.Write("bin", []byte("binary")))
+	assert.Equal(t, Digest([]byte("device binary")), upload.Digests()["bin"])
+	assert.True(t, errors.IsInvalid(upload.Validate(PipelineConfigKind)))
+	assert.NoError(t, upload.Commit(ctx, record, VerifyThenCommitMode, Digests{"bin": Digest([]byte("device binary"))}))
+	assert.Len(t, blobs(t), 2)
+	history, err := store.GetHistory(ctx, "fp_foo_leaf")
+	assert.NoError(t, err)
+	assert.Equal(t, VerifyThenCommitMode, history.ApplyMode)
+
+	ca, err := store.GetArtifacts(ctx, record)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("device binary"), ca["bin"])
+
+	// Upload a new revision
+	upload, err = store.NewUpload()
+	assert.NoError(t, err)
+	assert.NoError(t, upload.Write("bin", []byte("device binary v2")))
+	history, err = upload.CommitRevision(ctx, "fp_foo_leaf", nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), history.Current)
+
+	reader, _, err := store.OpenArtifact(ctx, "fp_foo_leaf@1", "bin")
+	assert.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("device binary"), data)
+	assert.NoError(t, reader.Close())
+
+	_, _, err = store.OpenArtifact(ctx, "fp_foo_leaf", "p4info")
+	assert.True(t, errors.IsNotFound(err))
+
+	// Corrupted artifacts should be detected at the end of the content
+	binPath := artifactsDir + "/" + blobDir + "/" + strings.TrimPrefix(Digest([]byte("device binary v2")), digestPrefix)
+	assert.NoError(t, os.WriteFile(binPath, []byte("device binary v3"), 0644))
+	reader, _, err = store.OpenArtifact(ctx, "fp_foo_leaf", "bin")
+	assert.NoError(t, err)
+	_, err = io.ReadAll(reader)
+	assert.True(t, IsDataLoss(err))
+	assert.NoError(t, reader.Close())
+
+	assert.NoError(t, store.Close())
+}
+
+func TestWatch(t *testing.T) {
+	cluster := test.NewClient()
+	defer cluster.Close()
+
+	_ = os.RemoveAll(artifactsDir)
+	backend, err := NewDirBackend(artifactsDir)
+	assert.NoError(t, err)
+	store, err := NewAtomixStore(cluster, backend, DefaultRevisionRetention)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.NoError(t, store.Add(ctx, &provisioner.ConfigRecord{ConfigID: "fp_foo_leaf", Kind: PipelineConfigKind}, Artifacts{"bin": []byte("bin")}))
+
+	ch := make(chan Event, depth)
+	assert.NoError(t, store.Watch(ctx, PipelineConfigKind, ch, true))
+	event := <-ch
+	assert.Equal(t, EventNone, event.Type)
+	assert.Equal(t, provisioner.ConfigID("fp_foo_leaf"), event.Record.ConfigID)
+
+	// Events for configurations of other kinds should be filtered out
+	assert.NoError(t, store.Add(ctx, &provisioner.ConfigRecord{ConfigID: "ch_foo_leaf", Kind: ChassisConfigKind}, Artifacts{"chassis": []byte("chassis")}))
+	assert.NoError(t, store.Add(ctx, &provisioner.ConfigRecord{ConfigID: "fp_foo_spine", Kind: PipelineConfigKind}, Artifacts{"bin": []byte("bin")}))
+	event = <-ch
+	assert.Equal(t, EventAdded, event.Type)
+	assert.Equal(t, provisioner.ConfigID("fp_foo_spine"), event.Record.ConfigID)
+
+	_, err = store.AddRevision(ctx, "fp_foo_spine", Artifacts{"bin": []byte("bin v2")}, 0)
+	assert.NoError(t, err)
+	event = <-ch
+	assert.Equal(t, EventUpdated, event.Type)
+	assert.Equal(t, provisioner.ConfigID("fp_foo_spine"), event.Record.ConfigID)
+
+	assert.NoError(t, store.Delete(ctx, "fp_foo_leaf"))
+	event = <-ch
+	assert.Equal(t, EventDeleted, event.Type)
+	assert.Equal(t, provisioner.ConfigID("fp_foo_leaf"), event.Record.ConfigID)
+
+	// The channel should be closed once the watch is cancelled
+	cancel()
+	for range ch {
+	}
+
+	assert.NoError(t, store.Close())
+}
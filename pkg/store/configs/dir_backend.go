@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+const (
+	blobDir          = "blobs"
+	blobFormat       = "%s/%s/%s" // root/blobs/hex
+	artifactDirPerms = 0755
+	artifactPerms    = 0644
+
+	lockDir     = "locks"
+	lockFormat  = "%s/%s/%s-%02x.lock" // root/locks/namespace-stripe.lock
+	lockStripes = 256
+
+	tempSuffix  = ".tmp-"
+	probePrefix = ".probe-"
+
+	legacyArtifactFormat = "%s/%s/%s.%s" // root/kind/id.type
+)
+
+// NewDirBackend returns an artifact backend which stores artifact blobs as files in the given directory,
+// typically located on a persistent volume shared by all provisioner instances; the instances coordinate
+// their access to the directory using file locks
+func NewDirBackend(path string) (ArtifactBackend, error) {
+	if err := os.MkdirAll(fmt.Sprintf("%s/%s", path, blobDir), artifactDirPerms); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(fmt.Sprintf("%s/%s", path, lockDir), artifactDirPerms); err != nil {
+		return nil, err
+	}
+	backend := &dirBackend{path: path}
+	backend.sweepTempFiles()
+	return backend, nil
+}
+
+type dirBackend struct {
+	path string
+}
+
+// Generates blob file path from the specified artifact digest
+func (b *dirBackend) blobPath(digest string) string {
+	return fmt.Sprintf(blobFormat, b.path, blobDir, strings.TrimPrefix(digest, digestPrefix))
+}
+
+func (b *dirBackend) Put(ctx context.Context, digest string, data []byte) error {
+	path := b.blobPath(digest)
+	if _, err := os.Stat(path); err == nil {
+		log.Debugf("Reusing existing artifact blob %s", digest)
+		return nil
+	}
+	return writeFileAtomically(path, bytes.NewReader(data))
+}
+
+func (b *dirBackend) PutStream(ctx context.Context, digest string, r io.Reader, size int64) error {
+	path := b.blobPath(digest)
+	if _, err := os.Stat(path); err == nil {
+		log.Debugf("Reusing existing artifact blob %s", digest)
+		return nil
+	}
+	return writeFileAtomically(path, r)
+}
+
+func (b *dirBackend) Get(ctx context.Context, digest string) ([]byte, error) {
+	data, err := os.ReadFile(b.blobPath(digest))
+	if os.IsNotExist(err) {
+		return nil, errors.NewNotFound("artifact blob %s not found", digest)
+	}
+	return data, err
+}
+
+func (b *dirBackend) GetStream(ctx context.Context, digest string) (io.ReadCloser, error) {
+	file, err := os.Open(b.blobPath(digest))
+	if os.IsNotExist(err) {
+		return nil, errors.NewNotFound("artifact blob %s not found", digest)
+	}
+	return file, err
+}
+
+func (b *dirBackend) Delete(ctx context.Context, digest string) error {
+	err := os.Remove(b.blobPath(digest))
+	if os.IsNotExist(err) {
+		return errors.NewNotFound("artifact blob %s not found", digest)
+	}
+	return err
+}
+
+func (b *dirBackend) List(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(fmt.Sprintf("%s/%s", b.path, blobDir))
+	if err != nil {
+		return nil, err
+	}
+	digests := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !strings.Contains(entry.Name(), tempSuffix) {
+			digests = append(digests, digestPrefix+entry.Name())
+		}
+	}
+	return digests, nil
+}
+
+// Check checks that the artifact directory is writable by creating and removing a probe file; the probe is
+// created outside the blob directory, so that it is never taken for a blob
+func (b *dirBackend) Check(ctx context.Context) error {
+	file, err := os.CreateTemp(b.path, probePrefix+"*")
+	if err != nil {
+		return errors.NewUnavailable("artifact directory %s is not writable: %v", b.path, err)
+	}
+	_ = file.Close()
+	if err = os.Remove(file.Name()); err != nil {
+		return errors.NewUnavailable("artifact directory %s is not writable: %v", b.path, err)
+	}
+	return nil
+}
+
+func (b *dirBackend) Close() error {
+	return nil
+}
+
+// Returns the content of the artifact file stored by earlier versions, which kept the artifacts of each
+// configuration in files named after it, rather than as blobs
+func (b *dirBackend) legacyArtifact(kind string, configID string, artifact string) ([]byte, error) {
+	data, err := os.ReadFile(fmt.Sprintf(legacyArtifactFormat, b.path, kind, configID, artifact))
+	if os.IsNotExist(err) {
+		return nil, errors.NewNotFound("artifact %s of configuration '%s' not found", artifact, configID)
+	}
+	return data, err
+}
+
+// Removes the artifact file stored by earlier versions, along with the directory of its kind once it is empty
+func (b *dirBackend) removeLegacyArtifact(kind string, configID string, artifact string) error {
+	if err := os.Remove(fmt.Sprintf(legacyArtifactFormat, b.path, kind, configID, artifact)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	_ = os.Remove(fmt.Sprintf("%s/%s", b.path, kind))
+	return nil
+}
+
+// Removes temporary files left behind by incomplete artifact writes; files of writes still in progress
+// are protected by their blob lock and are skipped
+func (b *dirBackend) sweepTempFiles() {
+	entries, err := os.ReadDir(fmt.Sprintf("%s/%s", b.path, blobDir))
+	if err != nil {
+		log.Warnf("Unable to list artifact blobs: %+v", err)
+		return
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		i := strings.Index(name, tempSuffix)
+		if i < 0 {
+			continue
+		}
+		unlock, ok := b.tryLock(blobLockNamespace, digestPrefix+name[:i])
+		if !ok {
+			continue
+		}
+		log.Infof("Sweeping orphaned artifact file %s", name)
+		_ = os.Remove(fmt.Sprintf("%s/%s/%s", b.path, blobDir, name))
+		unlock()
+	}
+}
+
+// Generates the path of the lock file guarding the given name within the given namespace; names are hashed
+// onto a fixed number of lock files to keep the number of lock files on the shared volume bounded
+func (b *dirBackend) lockPath(namespace string, name string) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(name))
+	return fmt.Sprintf(lockFormat, b.path, lockDir, namespace, hash.Sum32()%lockStripes)
+}
+
+// Acquires an exclusive advisory lock on the lock file for the given name, waiting until the lock is
+// available or the context is done; the lock is respected by all provisioner instances sharing the volume
+func (b *dirBackend) lock(ctx context.Context, namespace string, name string) (func(), error) {
+	for {
+		unlock, ok, err := b.acquire(namespace, name)
+		if err != nil || ok {
+			return unlock, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errors.NewTimeout("unable to acquire %s lock for %s: %v", namespace, name, ctx.Err())
+		case <-time.After(lockPollInterval):
+		}
+	}
+}
+
+// Attempts to acquire an exclusive advisory lock on the lock file for the given name without waiting
+func (b *dirBackend) tryLock(namespace string, name string) (func(), bool) {
+	unlock, ok, err := b.acquire(namespace, name)
+	if err != nil {
+		log.Warnf("Unable to acquire %s lock for %s: %+v", namespace, name, err)
+	}
+	return unlock, ok
+}
+
+func (b *dirBackend) acquire(namespace string, name string) (func(), bool, error) {
+	file, err := os.OpenFile(b.lockPath(namespace, name), os.O_CREATE|os.O_RDWR, artifactPerms)
+	if err != nil {
+		return nil, false, err
+	}
+	if err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		_ = file.Close()
+		if err == syscall.EWOULDBLOCK {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return func() {
+		_ = syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+		_ = file.Close()
+	}, true, nil
+}
+
+// Writes the data read from the reader to the specified path by staging it into a temporary file in the
+// same directory and renaming it into place, so that readers never observe a partially written file
+func writeFileAtomically(path string, r io.Reader) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+tempSuffix+"*")
+	if err != nil {
+		return err
+	}
+	tempPath := file.Name()
+	if _, err = io.Copy(file, r); err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tempPath, artifactPerms)
+	}
+	if err == nil {
+		err = os.Rename(tempPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tempPath)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/atomix/go-sdk/pkg/primitive"
+	_map "github.com/atomix/go-sdk/pkg/primitive/map"
+	"github.com/atomix/go-sdk/pkg/types"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// DefaultInlineMaxSize is the default limit on the size of artifacts stored using the inline backend
+const DefaultInlineMaxSize = 1024 * 1024
+
+// NewInlineBackend returns an artifact backend which stores artifact blobs directly in an Atomix map,
+// removing the need for a shared volume; it is suitable only for small artifacts, e.g. chassis
+// configurations, and it rejects artifacts larger than the given maximum size
+func NewInlineBackend(client primitive.Client, maxSize int) (ArtifactBackend, error) {
+	blobs, err := _map.NewBuilder[string, []byte](client, "onos-device-config-inline-blobs").
+		Tag("device-provisioner", "device-config-inline-blobs").
+		Codec(types.JSON[[]byte]()).
+		Get(context.Background())
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	return &inlineBackend{blobs: blobs, maxSize: maxSize}, nil
+}
+
+type inlineBackend struct {
+	blobs   _map.Map[string, []byte]
+	maxSize int
+}
+
+func (b *inlineBackend) Put(ctx context.Context, digest string, data []byte) error {
+	if len(data) > b.maxSize {
+		return errors.NewInvalid("artifact size %d exceeds the inline artifact size limit of %d", len(data), b.maxSize)
+	}
+	if _, err := b.blobs.Insert(ctx, digest, data); err != nil {
+		if err = errors.FromAtomix(err); !errors.IsAlreadyExists(err) {
+			return err
+		}
+		log.Debugf("Reusing existing artifact blob %s", digest)
+	}
+	return nil
+}
+
+func (b *inlineBackend) Get(ctx context.Context, digest string) ([]byte, error) {
+	entry, err := b.blobs.Get(ctx, digest)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	return entry.Value, nil
+}
+
+func (b *inlineBackend) Delete(ctx context.Context, digest string) error {
+	_, err := b.blobs.Remove(ctx, digest)
+	return errors.FromAtomix(err)
+}
+
+func (b *inlineBackend) List(ctx context.Context) ([]string, error) {
+	stream, err := b.blobs.List(ctx)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	digests := make([]string, 0)
+	for {
+		entry, err := stream.Next()
+		if err == io.EOF {
+			return digests, nil
+		}
+		if err != nil {
+			return nil, errors.FromAtomix(err)
+		}
+		digests = append(digests, entry.Key)
+	}
+}
+
+func (b *inlineBackend) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return errors.FromAtomix(b.blobs.Close(ctx))
+}
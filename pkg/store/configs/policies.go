@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/atomix/go-sdk/pkg/primitive"
+	_map "github.com/atomix/go-sdk/pkg/primitive/map"
+	"github.com/atomix/go-sdk/pkg/types"
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// AssignmentPolicy assigns configurations to the devices with the given labels which have no explicit
+// DeviceConfig aspect
+type AssignmentPolicy struct {
+	ID string `json:"id"`
+	// Selector are the labels which the devices must have; the policy applies to all devices if empty
+	Selector map[string]string `json:"selector,omitempty"`
+	// PipelineConfigID is the pipeline configuration reference assigned to the devices, if any
+	PipelineConfigID provisioner.ConfigID `json:"pipelineConfigID,omitempty"`
+	// ChassisConfigID is the chassis configuration reference assigned to the devices, if any
+	ChassisConfigID provisioner.ConfigID `json:"chassisConfigID,omitempty"`
+	// Priority orders the policies matching the same device; policies with higher priority take precedence
+	Priority int32     `json:"priority,omitempty"`
+	Created  time.Time `json:"created"`
+	Updated  time.Time `json:"updated"`
+	// Version is the version of the policy entry, for use with optimistic concurrency control
+	Version uint64 `json:"-"`
+}
+
+// Matches returns true if the device labels satisfy the policy selector
+func (p *AssignmentPolicy) Matches(labels map[string]string) bool {
+	return matchesSelector(p.Selector, labels)
+}
+
+// Returns true if the labels have all the labels of the selector with the same values
+func matchesSelector(selector map[string]string, labels map[string]string) bool {
+	for label, value := range selector {
+		if labels[label] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// AssignDeviceConfig returns the configurations assigned by the policies to a device with the given labels;
+// each kind of configuration is taken from the matching policy with the highest priority which assigns it,
+// with ties broken by the policy IDs. Returns false if no policy assigns any configuration to the device.
+func AssignDeviceConfig(policies []*AssignmentPolicy, labels map[string]string) (*provisioner.DeviceConfig, bool) {
+	sorted := make([]*AssignmentPolicy, len(policies))
+	copy(sorted, policies)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority > sorted[j].Priority
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	deviceConfig := &provisioner.DeviceConfig{}
+	for _, policy := range sorted {
+		if !policy.Matches(labels) {
+			continue
+		}
+		if deviceConfig.PipelineConfigID == "" {
+			deviceConfig.PipelineConfigID = policy.PipelineConfigID
+		}
+		if deviceConfig.ChassisConfigID == "" {
+			deviceConfig.ChassisConfigID = policy.ChassisConfigID
+		}
+	}
+	return deviceConfig, deviceConfig.PipelineConfigID != "" || deviceConfig.ChassisConfigID != ""
+}
+
+// PolicyStore is an abstraction for tracking policies assigning configurations to devices
+type PolicyStore interface {
+	io.Closer
+
+	// Create adds a new policy
+	Create(ctx context.Context, policy *AssignmentPolicy) error
+
+	// Get returns the specified policy
+	Get(ctx context.Context, id string) (*AssignmentPolicy, error)
+
+	// Update updates the policy, provided it was not updated since it was read; otherwise Conflict error is returned
+	Update(ctx context.Context, policy *AssignmentPolicy) error
+
+	// Delete removes the specified policy
+	Delete(ctx context.Context, id string) error
+
+	// List returns all policies
+	List(ctx context.Context) ([]*AssignmentPolicy, error)
+
+	// Watch streams policies as they are created, updated or deleted; this is a non-blocking method and
+	// the channel is closed once the context is done or the event stream fails
+	Watch(ctx context.Context, ch chan<- *AssignmentPolicy) error
+}
+
+// NewAtomixPolicyStore returns a new persistent store for assignment policies
+func NewAtomixPolicyStore(client primitive.Client) (PolicyStore, error) {
+	policies, err := _map.NewBuilder[string, *AssignmentPolicy](client, "onos-device-config-policies").
+		Tag("device-provisioner", "device-config-policies").
+		Codec(types.JSON[*AssignmentPolicy]()).
+		Get(context.Background())
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	return &atomixPolicyStore{policies: policies}, nil
+}
+
+type atomixPolicyStore struct {
+	policies _map.Map[string, *AssignmentPolicy]
+}
+
+func (s *atomixPolicyStore) Create(ctx context.Context, policy *AssignmentPolicy) error {
+	if policy.ID == "" {
+		return errors.NewInvalid("policy ID cannot be empty")
+	}
+	policy.Created = time.Now()
+	policy.Updated = policy.Created
+	entry, err := s.policies.Insert(ctx, policy.ID, policy)
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	policy.Version = uint64(entry.Version)
+	return nil
+}
+
+func (s *atomixPolicyStore) Get(ctx context.Context, id string) (*AssignmentPolicy, error) {
+	entry, err := s.policies.Get(ctx, id)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	return newPolicy(entry), nil
+}
+
+func (s *atomixPolicyStore) Update(ctx context.Context, policy *AssignmentPolicy) error {
+	policy.Updated = time.Now()
+	entry, err := s.policies.Update(ctx, policy.ID, policy, _map.IfVersion(primitive.Version(policy.Version)))
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	policy.Version = uint64(entry.Version)
+	return nil
+}
+
+func (s *atomixPolicyStore) Delete(ctx context.Context, id string) error {
+	if _, err := s.policies.Remove(ctx, id); err != nil {
+		return errors.FromAtomix(err)
+	}
+	return nil
+}
+
+func (s *atomixPolicyStore) List(ctx context.Context) ([]*AssignmentPolicy, error) {
+	stream, err := s.policies.List(ctx)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	policies := make([]*AssignmentPolicy, 0)
+	for {
+		entry, err := stream.Next()
+		if err == io.EOF {
+			return policies, nil
+		}
+		if err != nil {
+			return nil, errors.FromAtomix(err)
+		}
+		policies = append(policies, newPolicy(entry))
+	}
+}
+
+func (s *atomixPolicyStore) Watch(ctx context.Context, ch chan<- *AssignmentPolicy) error {
+	events, err := s.policies.Events(ctx)
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	go func() {
+		defer close(ch)
+		for {
+			event, err := events.Next()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					log.Warnf("Policy event stream failed: %+v", errors.FromAtomix(err))
+				}
+				return
+			}
+			var entry *_map.Entry[string, *AssignmentPolicy]
+			switch e := event.(type) {
+			case *_map.Inserted[string, *AssignmentPolicy]:
+				entry = e.Entry
+			case *_map.Updated[string, *AssignmentPolicy]:
+				entry = e.Entry
+			case *_map.Removed[string, *AssignmentPolicy]:
+				entry = e.Entry
+			default:
+				continue
+			}
+			select {
+			case ch <- newPolicy(entry):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *atomixPolicyStore) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.policies.Close(ctx); err != nil {
+		return errors.FromAtomix(err)
+	}
+	return nil
+}
+
+func newPolicy(entry *_map.Entry[string, *AssignmentPolicy]) *AssignmentPolicy {
+	policy := entry.Value
+	policy.Version = uint64(entry.Version)
+	return policy
+}
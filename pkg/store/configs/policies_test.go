@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPolicyStore(t *testing.T) {
+	store, err := NewAtomixPolicyStore(newTestCluster(t))
+	assert.NoError(t, err)
+	testEntryStore[*AssignmentPolicy](t, store, "pod01-spine", func(id string) *AssignmentPolicy {
+		return &AssignmentPolicy{ID: id, Selector: map[string]string{"role": "spine"}}
+	})
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	ch := make(chan *AssignmentPolicy, depth)
+	assert.NoError(t, store.Watch(ctx, ch))
+
+	leaf := &AssignmentPolicy{ID: "pod01-leaf", Selector: map[string]string{"role": "leaf", "realm": "pod01"},
+		PipelineConfigID: "fabric-leaf-v3", ChassisConfigID: "leaf-chassis"}
+	assert.NoError(t, store.Create(ctx, leaf))
+	assert.Equal(t, "pod01-leaf", (<-ch).ID)
+
+	p, err := store.Get(ctx, "pod01-leaf")
+	assert.NoError(t, err)
+	p.PipelineConfigID = "fabric-leaf-v4"
+	assert.NoError(t, store.Update(ctx, p))
+	assert.Equal(t, provisioner.ConfigID("fabric-leaf-v4"), (<-ch).PipelineConfigID)
+
+	assert.NoError(t, store.Delete(ctx, "pod01-leaf"))
+	assert.Equal(t, "pod01-leaf", (<-ch).ID)
+
+	assert.NoError(t, store.Close())
+}
+
+func TestAssignDeviceConfig(t *testing.T) {
+	policies := []*AssignmentPolicy{
+		{ID: "default", ChassisConfigID: "generic-chassis"},
+		{ID: "leaf", Selector: map[string]string{"role": "leaf"}, PipelineConfigID: "fabric-leaf", Priority: 10},
+		{ID: "pod01-leaf", Selector: map[string]string{"role": "leaf", "pod": "pod01"},
+			PipelineConfigID: "fabric-leaf-v3", ChassisConfigID: "leaf-chassis", Priority: 20},
+	}
+
+	dc, ok := AssignDeviceConfig(policies, map[string]string{"role": "leaf", "pod": "pod01"})
+	assert.True(t, ok)
+	assert.Equal(t, provisioner.ConfigID("fabric-leaf-v3"), dc.PipelineConfigID)
+	assert.Equal(t, provisioner.ConfigID("leaf-chassis"), dc.ChassisConfigID)
+
+	dc, ok = AssignDeviceConfig(policies, map[string]string{"role": "leaf", "pod": "pod02"})
+	assert.True(t, ok)
+	assert.Equal(t, provisioner.ConfigID("fabric-leaf"), dc.PipelineConfigID)
+	assert.Equal(t, provisioner.ConfigID("generic-chassis"), dc.ChassisConfigID)
+
+	dc, ok = AssignDeviceConfig(policies, map[string]string{"role": "spine"})
+	assert.True(t, ok)
+	assert.Equal(t, provisioner.ConfigID(""), dc.PipelineConfigID)
+	assert.Equal(t, provisioner.ConfigID("generic-chassis"), dc.ChassisConfigID)
+
+	_, ok = AssignDeviceConfig(policies[1:], map[string]string{"role": "spine"})
+	assert.False(t, ok)
+}
@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	_map "github.com/atomix/go-sdk/pkg/primitive/map"
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// RevisionSeparator separates the configuration ID and the revision number in a configuration reference,
+// e.g. 'fabric-leaf@3'; references without a revision number float to the current revision
+const RevisionSeparator = "@"
+
+// Revision is an immutable revision of configuration artifacts
+type Revision struct {
+	Number  uint64    `json:"number"`
+	Digests Digests   `json:"digests"`
+	Created time.Time `json:"created"`
+	// Sizes are the sizes of the artifacts in bytes; revisions added by earlier versions do not record them
+	Sizes map[string]int64 `json:"sizes,omitempty"`
+}
+
+// History is the ordered history of revisions of a configuration
+type History struct {
+	// Current is the number of the revision to which references without a revision number resolve
+	Current   uint64      `json:"current"`
+	Revisions []*Revision `json:"revisions"`
+	// ApplyMode is the mode in which a pipeline configuration is applied to the devices; VerifyAndCommitMode
+	// is used if it is empty
+	ApplyMode string `json:"applyMode,omitempty"`
+	// Version is the version of the history entry, for use with optimistic concurrency control
+	Version uint64 `json:"-"`
+}
+
+// Revision returns the revision with the given number or nil if there is no such revision
+func (h *History) Revision(number uint64) *Revision {
+	for _, revision := range h.Revisions {
+		if revision.Number == number {
+			return revision
+		}
+	}
+	return nil
+}
+
+// Prunes the oldest revisions, so that at most the given number of revisions is retained besides the pinned
+// revisions; returns the pruned revisions. It is used as revisions are added, so the current revision is always
+// the latest one and retained.
+func (h *History) prune(retention int, pinned map[uint64]bool) []*Revision {
+	if len(h.Revisions) <= retention {
+		return nil
+	}
+	n := len(h.Revisions) - retention
+	var pruned []*Revision
+	retained := make([]*Revision, 0, retention)
+	for _, revision := range h.Revisions[:n] {
+		if pinned[revision.Number] {
+			retained = append(retained, revision)
+		} else {
+			pruned = append(pruned, revision)
+		}
+	}
+	h.Revisions = append(retained, h.Revisions[n:]...)
+	return pruned
+}
+
+// Latest returns the number of the most recently added revision
+func (h *History) Latest() uint64 {
+	if len(h.Revisions) == 0 {
+		return 0
+	}
+	return h.Revisions[len(h.Revisions)-1].Number
+}
+
+// Returns the sorted artifact types
+func (d Digests) types() []string {
+	types := make([]string, 0, len(d))
+	for artifact := range d {
+		types = append(types, artifact)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ParseRef splits the given configuration reference into the configuration ID and the revision number;
+// revision number 0 indicates the current revision
+func ParseRef(ref provisioner.ConfigID) (provisioner.ConfigID, uint64, error) {
+	i := strings.LastIndex(string(ref), RevisionSeparator)
+	if i < 0 {
+		return ref, 0, nil
+	}
+	revision, err := strconv.ParseUint(string(ref[i+1:]), 10, 64)
+	if err != nil || revision == 0 {
+		return "", 0, errors.NewInvalid("invalid revision in configuration reference '%s'", ref)
+	}
+	return ref[:i], revision, nil
+}
+
+// Ref returns a reference to the specified revision of the configuration
+func Ref(configID provisioner.ConfigID, revision uint64) provisioner.ConfigID {
+	return provisioner.ConfigID(fmt.Sprintf("%s%s%d", configID, RevisionSeparator, revision))
+}
+
+// PinnedRevisions returns the numbers of the revisions of the specified configuration which are referenced
+// by number, e.g. 'fabric-leaf@3', and therefore must not be pruned
+type PinnedRevisions func(ctx context.Context, configID provisioner.ConfigID) (map[uint64]bool, error)
+
+// SetPinnedRevisions sets the function determining the revisions which must not be pruned
+func (s *atomixStore) SetPinnedRevisions(pinned PinnedRevisions) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pinned = pinned
+}
+
+// Returns the numbers of the pinned revisions of the configuration; none unless the function is set
+func (s *atomixStore) pinnedRevisions(ctx context.Context, configID provisioner.ConfigID) (map[uint64]bool, error) {
+	s.mu.RLock()
+	pinned := s.pinned
+	s.mu.RUnlock()
+	if pinned == nil {
+		return nil, nil
+	}
+	return pinned(ctx, configID)
+}
+
+// AddRevision adds a new immutable revision of artifacts to an existing configuration and makes it current
+func (s *atomixStore) AddRevision(ctx context.Context, configID provisioner.ConfigID, artifacts Artifacts, version uint64) (*History, error) {
+	return s.addRevision(ctx, configID, newBlobs(artifacts), version)
+}
+
+// Adds a new revision to an existing configuration, storing its artifacts from the given blobs
+func (s *atomixStore) addRevision(ctx context.Context, configID provisioner.ConfigID, blobs map[string]*blob, version uint64) (*History, error) {
+	if configID == "" || strings.Contains(string(configID), RevisionSeparator) {
+		return nil, errors.NewInvalid("ConfigID cannot be empty or a revision reference")
+	}
+	if len(blobs) == 0 {
+		return nil, errors.NewInvalid("Artifacts cannot be empty")
+	}
+
+	log.Infof("Adding revision of configuration '%s'", configID)
+	unlock, err := s.locks.lock(ctx, configLockNamespace, string(configID))
+	if err != nil {
+		log.Warnf("Failed to add revision of configuration '%s': %v", configID, err)
+		return nil, err
+	}
+	defer unlock()
+
+	entry, err := s.configs.Get(ctx, configID)
+	if err != nil {
+		err = errors.FromAtomix(err)
+		log.Warnf("Failed to add revision of configuration '%s': %v", configID, err)
+		return nil, err
+	}
+	historyEntry, err := s.revisions.Get(ctx, configID)
+	if err != nil {
+		err = errors.FromAtomix(err)
+		log.Warnf("Failed to add revision of configuration '%s': %v", configID, err)
+		return nil, err
+	}
+
+	if version != 0 && version != uint64(historyEntry.Version) {
+		log.Warnf("Failed to add revision of configuration '%s': version %d is not current", configID, version)
+		return nil, errors.NewConflict("configuration '%s' has been modified since version %d", configID, version)
+	}
+
+	// revisions still referenced by number are retained, so that they keep resolving; if the references
+	// cannot be determined, the revision is not added rather than pruning a revision which may be in use
+	pinned, err := s.pinnedRevisions(ctx, configID)
+	if err != nil {
+		log.Warnf("Failed to add revision of configuration '%s': unable to determine pinned revisions: %v", configID, err)
+		return nil, err
+	}
+
+	record := entry.Value
+	digests, err := s.saveArtifacts(ctx, record, blobs)
+	if err != nil {
+		return nil, err
+	}
+
+	history := historyEntry.Value
+	revision := &Revision{Number: history.Latest() + 1, Digests: digests, Created: time.Now(), Sizes: blobSizes(blobs)}
+	history.Revisions = append(history.Revisions, revision)
+	history.Current = revision.Number
+	pruned := history.prune(s.retention, pinned)
+	updated, err := s.revisions.Update(ctx, configID, history, _map.IfVersion(historyEntry.Version))
+	if err != nil {
+		s.releaseArtifacts(ctx, digests)
+		err = errors.FromAtomix(err)
+		log.Warnf("Failed to add revision of configuration '%s': %v", configID, err)
+		return nil, err
+	}
+	// the artifacts of the pruned revisions are deleted unless other revisions or configurations share them
+	for _, r := range pruned {
+		log.Infof("Pruning revision %d of configuration '%s'", r.Number, configID)
+		s.releaseArtifacts(ctx, r.Digests)
+	}
+	s.updateRecord(ctx, record, history)
+	history.Version = uint64(updated.Version)
+	log.Infof("Added revision %d of configuration '%s'", revision.Number, configID)
+	return history, nil
+}
+
+// Rollback makes the specified prior revision the current revision of the configuration
+func (s *atomixStore) Rollback(ctx context.Context, configID provisioner.ConfigID, revision uint64) error {
+	log.Infof("Rolling back configuration '%s' to revision %d", configID, revision)
+	unlock, err := s.locks.lock(ctx, configLockNamespace, string(configID))
+	if err != nil {
+		log.Warnf("Failed to roll back configuration '%s': %v", configID, err)
+		return err
+	}
+	defer unlock()
+
+	entry, err := s.configs.Get(ctx, configID)
+	if err != nil {
+		err = errors.FromAtomix(err)
+		log.Warnf("Failed to roll back configuration '%s': %v", configID, err)
+		return err
+	}
+	historyEntry, err := s.revisions.Get(ctx, configID)
+	if err != nil {
+		err = errors.FromAtomix(err)
+		log.Warnf("Failed to roll back configuration '%s': %v", configID, err)
+		return err
+	}
+
+	history := historyEntry.Value
+	if history.Revision(revision) == nil {
+		log.Warnf("Failed to roll back configuration '%s': revision %d not found", configID, revision)
+		return errors.NewNotFound("revision %d of configuration '%s' not found", revision, configID)
+	}
+	history.Current = revision
+	if _, err = s.revisions.Update(ctx, configID, history, _map.IfVersion(historyEntry.Version)); err != nil {
+		err = errors.FromAtomix(err)
+		log.Warnf("Failed to roll back configuration '%s': %v", configID, err)
+		return err
+	}
+	s.updateRecord(ctx, entry.Value, history)
+	return nil
+}
+
+// SetApplyMode sets the mode in which the specified pipeline configuration is applied to the devices
+func (s *atomixStore) SetApplyMode(ctx context.Context, configID provisioner.ConfigID, mode string) error {
+	log.Infof("Setting apply mode of configuration '%s' to %s", configID, mode)
+	if err := validateApplyMode(mode); err != nil {
+		return err
+	}
+	unlock, err := s.locks.lock(ctx, configLockNamespace, string(configID))
+	if err != nil {
+		log.Warnf("Failed to set apply mode of configuration '%s': %v", configID, err)
+		return err
+	}
+	defer unlock()
+
+	entry, err := s.configs.Get(ctx, configID)
+	if err != nil {
+		err = errors.FromAtomix(err)
+		log.Warnf("Failed to set apply mode of configuration '%s': %v", configID, err)
+		return err
+	}
+	if entry.Value.Kind != PipelineConfigKind {
+		return errors.NewInvalid("apply mode can be set only for %s configurations", PipelineConfigKind)
+	}
+	historyEntry, err := s.revisions.Get(ctx, configID)
+	if err != nil {
+		err = errors.FromAtomix(err)
+		log.Warnf("Failed to set apply mode of configuration '%s': %v", configID, err)
+		return err
+	}
+	history := historyEntry.Value
+	history.ApplyMode = mode
+	if _, err = s.revisions.Update(ctx, configID, history, _map.IfVersion(historyEntry.Version)); err != nil {
+		err = errors.FromAtomix(err)
+		log.Warnf("Failed to set apply mode of configuration '%s': %v", configID, err)
+		return err
+	}
+	return nil
+}
+
+// GetHistory returns the history of revisions of the specified configuration
+func (s *atomixStore) GetHistory(ctx context.Context, configID provisioner.ConfigID) (*History, error) {
+	entry, err := s.revisions.Get(ctx, configID)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	history := entry.Value
+	history.Version = uint64(entry.Version)
+	return history, nil
+}
+
+// Resolve resolves the given configuration reference into a reference to a specific revision
+func (s *atomixStore) Resolve(ctx context.Context, ref provisioner.ConfigID) (provisioner.ConfigID, error) {
+	configID, revision, err := ParseRef(ref)
+	if err != nil {
+		return "", err
+	}
+	history, err := s.GetHistory(ctx, configID)
+	if err != nil {
+		return "", err
+	}
+	if revision == 0 {
+		revision = history.Current
+	}
+	if history.Revision(revision) == nil {
+		return "", errors.NewNotFound("revision %d of configuration '%s' not found", revision, configID)
+	}
+	return Ref(configID, revision), nil
+}
+
+// Updates the artifact types listed by the configuration record to match the current revision
+func (s *atomixStore) updateRecord(ctx context.Context, record *provisioner.ConfigRecord, history *History) {
+	record.Artifacts = history.Revision(history.Current).Digests.types()
+	if _, err := s.configs.Put(ctx, record.ConfigID, record); err != nil {
+		log.Warnf("Unable to update record of configuration '%s': %v", record.ConfigID, errors.FromAtomix(err))
+	}
+}
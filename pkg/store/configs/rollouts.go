@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/atomix/go-sdk/pkg/primitive"
+	_map "github.com/atomix/go-sdk/pkg/primitive/map"
+	"github.com/atomix/go-sdk/pkg/types"
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// RolloutState is the state of a rollout
+type RolloutState string
+
+const (
+	// RolloutPending indicates a rollout whose devices have not been selected yet
+	RolloutPending RolloutState = "PENDING"
+	// RolloutRunning indicates a rollout moving its devices to the new configuration in waves
+	RolloutRunning RolloutState = "RUNNING"
+	// RolloutCompleted indicates a rollout which moved all its devices to the new configuration
+	RolloutCompleted RolloutState = "COMPLETED"
+	// RolloutRollingBack indicates a halted rollout moving its devices back to the original configuration
+	RolloutRollingBack RolloutState = "ROLLING_BACK"
+	// RolloutRolledBack indicates a halted rollout whose devices were moved back to the original configuration
+	RolloutRolledBack RolloutState = "ROLLED_BACK"
+)
+
+// Done returns true if the rollout reached a final state
+func (s RolloutState) Done() bool {
+	return s == RolloutCompleted || s == RolloutRolledBack
+}
+
+// Rollout moves a set of devices, selected by their labels, from one configuration to another in waves
+type Rollout struct {
+	ID string `json:"id"`
+	// Realm is the realm in which the rollout was created; only the devices of the realm are selected, and
+	// the rollout is advanced and listed only by the provisioners of the realm
+	Realm string `json:"realm"`
+	// Kind is the kind of the configurations, i.e. pipeline or chassis
+	Kind string `json:"kind"`
+	// Selector are the labels which the devices must have; devices of the whole realm are selected if empty
+	Selector map[string]string `json:"selector,omitempty"`
+	// FromConfigID is the configuration reference which the devices must have to be selected
+	FromConfigID provisioner.ConfigID `json:"fromConfigID"`
+	// ToConfigID is the configuration reference to which the devices are moved
+	ToConfigID provisioner.ConfigID `json:"toConfigID"`
+	// BatchSize is the number of devices moved in each wave
+	BatchSize uint32 `json:"batchSize"`
+	// SoakTime is the time for which the devices of a wave must keep the new configuration before the next wave
+	SoakTime time.Duration `json:"soakTime"`
+	// FailureThreshold is the number of devices which may fail to apply the new configuration before the rollout
+	// is halted and rolled back
+	FailureThreshold uint32 `json:"failureThreshold"`
+
+	State RolloutState `json:"state"`
+	// Devices are the selected devices in the order of the waves
+	Devices []topo.ID `json:"devices,omitempty"`
+	// Wave is the number of waves started so far
+	Wave uint32 `json:"wave"`
+	// WaveSettled is the time at which all devices of the current wave applied the new configuration
+	// or failed to apply it; the soak time counts from it
+	WaveSettled time.Time `json:"waveSettled,omitempty"`
+	// Failed are the devices which failed to apply the new configuration
+	Failed []topo.ID `json:"failed,omitempty"`
+	// Message explains the state of the rollout, e.g. the reason why it was halted
+	Message string    `json:"message,omitempty"`
+	Created time.Time `json:"created"`
+	Updated time.Time `json:"updated"`
+	// Version is the version of the rollout entry, for use with optimistic concurrency control
+	Version uint64 `json:"-"`
+}
+
+// Matches returns true if the device labels satisfy the rollout selector
+func (r *Rollout) Matches(labels map[string]string) bool {
+	return matchesSelector(r.Selector, labels)
+}
+
+// Moved returns the devices moved to the new configuration by the waves started so far
+func (r *Rollout) Moved() []topo.ID {
+	n := int(r.Wave * r.BatchSize)
+	if n > len(r.Devices) {
+		n = len(r.Devices)
+	}
+	return r.Devices[:n]
+}
+
+// CurrentWave returns the devices of the most recently started wave
+func (r *Rollout) CurrentWave() []topo.ID {
+	if r.Wave == 0 {
+		return nil
+	}
+	moved := r.Moved()
+	start := int((r.Wave - 1) * r.BatchSize)
+	if start > len(moved) {
+		start = len(moved)
+	}
+	return moved[start:]
+}
+
+// RolloutStore is an abstraction for tracking rollouts of configurations to devices
+type RolloutStore interface {
+	io.Closer
+
+	// Create adds a new rollout
+	Create(ctx context.Context, rollout *Rollout) error
+
+	// Get returns the specified rollout
+	Get(ctx context.Context, id string) (*Rollout, error)
+
+	// Update updates the rollout, provided it was not updated since it was read; otherwise Conflict error is returned
+	Update(ctx context.Context, rollout *Rollout) error
+
+	// Delete removes the specified rollout
+	Delete(ctx context.Context, id string) error
+
+	// List returns all rollouts
+	List(ctx context.Context) ([]*Rollout, error)
+
+	// Watch streams rollouts as they are created or updated, starting with the existing rollouts; this is
+	// a non-blocking method and the channel is closed once the context is done or the event stream fails
+	Watch(ctx context.Context, ch chan<- *Rollout) error
+}
+
+// NewAtomixRolloutStore returns a new persistent store for rollouts
+func NewAtomixRolloutStore(client primitive.Client) (RolloutStore, error) {
+	rollouts, err := _map.NewBuilder[string, *Rollout](client, "onos-device-config-rollouts").
+		Tag("device-provisioner", "device-config-rollouts").
+		Codec(types.JSON[*Rollout]()).
+		Get(context.Background())
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	return &atomixRolloutStore{rollouts: rollouts}, nil
+}
+
+type atomixRolloutStore struct {
+	rollouts _map.Map[string, *Rollout]
+}
+
+func (s *atomixRolloutStore) Create(ctx context.Context, rollout *Rollout) error {
+	if rollout.ID == "" {
+		return errors.NewInvalid("rollout ID cannot be empty")
+	}
+	rollout.State = RolloutPending
+	rollout.Created = time.Now()
+	rollout.Updated = rollout.Created
+	entry, err := s.rollouts.Insert(ctx, rollout.ID, rollout)
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	rollout.Version = uint64(entry.Version)
+	return nil
+}
+
+func (s *atomixRolloutStore) Get(ctx context.Context, id string) (*Rollout, error) {
+	entry, err := s.rollouts.Get(ctx, id)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	return newRollout(entry), nil
+}
+
+func (s *atomixRolloutStore) Update(ctx context.Context, rollout *Rollout) error {
+	rollout.Updated = time.Now()
+	entry, err := s.rollouts.Update(ctx, rollout.ID, rollout, _map.IfVersion(primitive.Version(rollout.Version)))
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	rollout.Version = uint64(entry.Version)
+	return nil
+}
+
+func (s *atomixRolloutStore) Delete(ctx context.Context, id string) error {
+	if _, err := s.rollouts.Remove(ctx, id); err != nil {
+		return errors.FromAtomix(err)
+	}
+	return nil
+}
+
+func (s *atomixRolloutStore) List(ctx context.Context) ([]*Rollout, error) {
+	stream, err := s.rollouts.List(ctx)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	rollouts := make([]*Rollout, 0)
+	for {
+		entry, err := stream.Next()
+		if err == io.EOF {
+			return rollouts, nil
+		}
+		if err != nil {
+			return nil, errors.FromAtomix(err)
+		}
+		rollouts = append(rollouts, newRollout(entry))
+	}
+}
+
+func (s *atomixRolloutStore) Watch(ctx context.Context, ch chan<- *Rollout) error {
+	// subscribe to events before listing the existing entries, so that no changes are missed in between
+	events, err := s.rollouts.Events(ctx)
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	rollouts, err := s.List(ctx)
+	if err != nil {
+		return err
+	}
+	go func() {
+		defer close(ch)
+		send := func(rollout *Rollout) bool {
+			select {
+			case ch <- rollout:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		for _, rollout := range rollouts {
+			if !send(rollout) {
+				return
+			}
+		}
+		for {
+			event, err := events.Next()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					log.Warnf("Rollout event stream failed: %+v", errors.FromAtomix(err))
+				}
+				return
+			}
+			var entry *_map.Entry[string, *Rollout]
+			switch e := event.(type) {
+			case *_map.Inserted[string, *Rollout]:
+				entry = e.Entry
+			case *_map.Updated[string, *Rollout]:
+				entry = e.Entry
+			default:
+				continue
+			}
+			if !send(newRollout(entry)) {
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *atomixRolloutStore) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.rollouts.Close(ctx); err != nil {
+		return errors.FromAtomix(err)
+	}
+	return nil
+}
+
+func newRollout(entry *_map.Entry[string, *Rollout]) *Rollout {
+	rollout := entry.Value
+	rollout.Version = uint64(entry.Version)
+	return rollout
+}
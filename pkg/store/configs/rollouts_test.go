@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestRolloutStore(t *testing.T) {
+	store, err := NewAtomixRolloutStore(newTestCluster(t))
+	assert.NoError(t, err)
+	testEntryStore[*Rollout](t, store, "spine-upgrade", func(id string) *Rollout {
+		return &Rollout{ID: id, Kind: PipelineConfigKind, FromConfigID: "fabric-spine@1", ToConfigID: "fabric-spine@2"}
+	})
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	ch := make(chan *Rollout, depth)
+	assert.NoError(t, store.Watch(ctx, ch))
+
+	rollout := &Rollout{ID: "leaf-upgrade", Kind: PipelineConfigKind, Selector: map[string]string{"role": "leaf"},
+		FromConfigID: "fabric-leaf@1", ToConfigID: "fabric-leaf@2", BatchSize: 2, SoakTime: time.Minute}
+	assert.NoError(t, store.Create(ctx, rollout))
+	assert.Equal(t, RolloutPending, rollout.State)
+
+	created := <-ch
+	assert.Equal(t, "leaf-upgrade", created.ID)
+	assert.Equal(t, RolloutPending, created.State)
+
+	// Start the rollout and the first wave
+	r, err := store.Get(ctx, "leaf-upgrade")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, r.SoakTime)
+	r.State = RolloutRunning
+	r.Devices = []topo.ID{"leaf1", "leaf2", "leaf3"}
+	r.Wave = 1
+	assert.NoError(t, store.Update(ctx, r))
+	assert.Equal(t, []topo.ID{"leaf1", "leaf2"}, r.Moved())
+	assert.Equal(t, []topo.ID{"leaf1", "leaf2"}, r.CurrentWave())
+
+	updated := <-ch
+	assert.Equal(t, RolloutRunning, updated.State)
+	assert.Equal(t, uint32(1), updated.Wave)
+
+	r.Wave = 2
+	assert.NoError(t, store.Update(ctx, r))
+	assert.Equal(t, []topo.ID{"leaf1", "leaf2", "leaf3"}, r.Moved())
+	assert.Equal(t, []topo.ID{"leaf3"}, r.CurrentWave())
+	assert.Equal(t, uint32(2), (<-ch).Wave)
+
+	assert.NoError(t, store.Close())
+}
@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+const (
+	s3Algorithm  = "AWS4-HMAC-SHA256"
+	s3Service    = "s3"
+	s3DateFormat = "20060102T150405Z"
+	s3DayFormat  = "20060102"
+
+	// s3UnsignedPayload is used in place of the payload hash for streamed request bodies
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+)
+
+// ObjectStoreConfig is the configuration of an S3-compatible object store used for storing artifacts
+type ObjectStoreConfig struct {
+	// Endpoint is the base URL of the object store, e.g. http://minio:9000
+	Endpoint string
+	// Bucket is the name of the bucket holding the artifacts
+	Bucket string
+	// Prefix is an optional prefix for the object keys of the artifacts
+	Prefix string
+	// Region is the region used for signing the requests
+	Region string
+	// AccessKeyID is the access key used for signing the requests
+	AccessKeyID string
+	// SecretAccessKey is the secret key used for signing the requests
+	SecretAccessKey string
+}
+
+// NewObjectStoreBackend returns an artifact backend which stores artifact blobs as objects in a bucket
+// of an S3-compatible object store, e.g. MinIO, using path-style requests signed with AWS signature V4
+func NewObjectStoreBackend(config ObjectStoreConfig) (ArtifactBackend, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, errors.NewInvalid("object store endpoint and bucket must be specified")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	return &objectStoreBackend{
+		config: config,
+		client: &http.Client{},
+	}, nil
+}
+
+type objectStoreBackend struct {
+	config ObjectStoreConfig
+	client *http.Client
+}
+
+func (b *objectStoreBackend) key(digest string) string {
+	return b.config.Prefix + strings.TrimPrefix(digest, digestPrefix)
+}
+
+func (b *objectStoreBackend) Put(ctx context.Context, digest string, data []byte) error {
+	response, err := b.do(ctx, http.MethodHead, b.key(digest), nil, nil)
+	if err == nil {
+		log.Debugf("Reusing existing artifact blob %s", digest)
+		return response.Body.Close()
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+	response, err = b.do(ctx, http.MethodPut, b.key(digest), nil, data)
+	if err != nil {
+		return err
+	}
+	return response.Body.Close()
+}
+
+func (b *objectStoreBackend) PutStream(ctx context.Context, digest string, r io.Reader, size int64) error {
+	response, err := b.do(ctx, http.MethodHead, b.key(digest), nil, nil)
+	if err == nil {
+		log.Debugf("Reusing existing artifact blob %s", digest)
+		return response.Body.Close()
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+	response, err = b.doStream(ctx, http.MethodPut, b.key(digest), r, size)
+	if err != nil {
+		return err
+	}
+	return response.Body.Close()
+}
+
+func (b *objectStoreBackend) GetStream(ctx context.Context, digest string) (io.ReadCloser, error) {
+	response, err := b.do(ctx, http.MethodGet, b.key(digest), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+func (b *objectStoreBackend) Get(ctx context.Context, digest string) ([]byte, error) {
+	response, err := b.do(ctx, http.MethodGet, b.key(digest), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	return io.ReadAll(response.Body)
+}
+
+func (b *objectStoreBackend) Delete(ctx context.Context, digest string) error {
+	response, err := b.do(ctx, http.MethodDelete, b.key(digest), nil, nil)
+	if err != nil {
+		return err
+	}
+	return response.Body.Close()
+}
+
+// listBucketResult is the response of the S3 ListObjectsV2 request
+type listBucketResult struct {
+	Contents []struct {
+		Key string
+	}
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+func (b *objectStoreBackend) List(ctx context.Context) ([]string, error) {
+	digests := make([]string, 0)
+	query := url.Values{"list-type": {"2"}, "prefix": {b.config.Prefix}}
+	for {
+		response, err := b.do(ctx, http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		result := &listBucketResult{}
+		err = xml.NewDecoder(response.Body).Decode(result)
+		_ = response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range result.Contents {
+			digests = append(digests, digestPrefix+strings.TrimPrefix(object.Key, b.config.Prefix))
+		}
+		if !result.IsTruncated {
+			return digests, nil
+		}
+		query.Set("continuation-token", result.NextContinuationToken)
+	}
+}
+
+// Check checks that the bucket is accessible
+func (b *objectStoreBackend) Check(ctx context.Context) error {
+	response, err := b.do(ctx, http.MethodHead, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	return response.Body.Close()
+}
+
+func (b *objectStoreBackend) Close() error {
+	b.client.CloseIdleConnections()
+	return nil
+}
+
+// Issues a signed request for the given object key, or for the bucket if the key is empty; responses
+// with an error status are converted to errors
+func (b *objectStoreBackend) do(ctx context.Context, method string, key string, query url.Values, body []byte) (*http.Response, error) {
+	payloadHash := sha256.Sum256(body)
+	return b.send(ctx, method, key, query, bytes.NewReader(body), int64(len(body)), hex.EncodeToString(payloadHash[:]))
+}
+
+// Issues a signed request for the given object key with the body streamed from the reader; the payload
+// is left unsigned, as its hash cannot be computed without reading the body in advance
+func (b *objectStoreBackend) doStream(ctx context.Context, method string, key string, body io.Reader, size int64) (*http.Response, error) {
+	return b.send(ctx, method, key, nil, body, size, s3UnsignedPayload)
+}
+
+func (b *objectStoreBackend) send(ctx context.Context, method string, key string, query url.Values, body io.Reader, size int64, payloadHash string) (*http.Response, error) {
+	path := "/" + b.config.Bucket
+	if key != "" {
+		path += "/" + key
+	}
+	request, err := http.NewRequestWithContext(ctx, method, b.config.Endpoint+path, body)
+	if err != nil {
+		return nil, err
+	}
+	request.ContentLength = size
+	request.URL.RawQuery = canonicalQuery(query)
+	b.sign(request, payloadHash, time.Now().UTC())
+
+	response, err := b.client.Do(request)
+	if err != nil {
+		return nil, errors.NewUnavailable("object store request failed: %v", err)
+	}
+	if response.StatusCode < 300 {
+		return response, nil
+	}
+	_ = response.Body.Close()
+	switch response.StatusCode {
+	case http.StatusNotFound:
+		return nil, errors.NewNotFound("object %s not found in bucket %s", key, b.config.Bucket)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, errors.NewForbidden("object store request %s %s rejected: %s", method, path, response.Status)
+	default:
+		return nil, errors.NewUnavailable("object store request %s %s failed: %s", method, path, response.Status)
+	}
+}
+
+// Signs the request using AWS signature version 4
+func (b *objectStoreBackend) sign(request *http.Request, payloadHash string, now time.Time) {
+	request.Header.Set("Host", request.URL.Host)
+	request.Header.Set("X-Amz-Date", now.Format(s3DateFormat))
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if b.config.AccessKeyID == "" {
+		return
+	}
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		"host:" + request.URL.Host,
+		"x-amz-content-sha256:" + request.Header.Get("X-Amz-Content-Sha256"),
+		"x-amz-date:" + request.Header.Get("X-Amz-Date"),
+		"",
+		signedHeaders,
+		request.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+
+	scope := fmt.Sprintf("%s/%s/%s/aws4_request", now.Format(s3DayFormat), b.config.Region, s3Service)
+	stringToSign := strings.Join([]string{s3Algorithm, now.Format(s3DateFormat), scope, hex.EncodeToString(canonicalHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+b.config.SecretAccessKey), now.Format(s3DayFormat))
+	for _, part := range []string{b.config.Region, s3Service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, b.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// Encodes the query parameters sorted by key, as required for signing
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	params := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range query[k] {
+			params = append(params, url.QueryEscape(k)+"="+strings.ReplaceAll(url.QueryEscape(v), "+", "%20"))
+		}
+	}
+	return strings.Join(params, "&")
+}
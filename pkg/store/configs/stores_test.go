@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"github.com/atomix/go-sdk/pkg/test"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// Returns an Atomix test client that is closed when the test completes
+func newTestCluster(t *testing.T) *test.Client {
+	cluster := test.NewClient()
+	t.Cleanup(cluster.Close)
+	return cluster
+}
+
+// entryStore is the part common to the stores of policies, rollouts and batches
+type entryStore[T any] interface {
+	Create(ctx context.Context, entry T) error
+	Get(ctx context.Context, id string) (T, error)
+	Update(ctx context.Context, entry T) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]T, error)
+}
+
+// Exercises the operations common to the stores of policies, rollouts and batches with an entry with the given ID,
+// created by newEntry; the entry is created, updated once and deleted, and the store is left empty
+func testEntryStore[T any](t *testing.T, store entryStore[T], id string, newEntry func(id string) T) {
+	ctx := context.TODO()
+	entry := newEntry(id)
+	assert.NoError(t, store.Create(ctx, entry))
+	assert.True(t, errors.IsAlreadyExists(store.Create(ctx, newEntry(id))))
+	assert.True(t, errors.IsInvalid(store.Create(ctx, newEntry(""))))
+
+	// Updates based on a stale version are rejected
+	stale, err := store.Get(ctx, id)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Update(ctx, entry))
+	assert.True(t, errors.IsConflict(store.Update(ctx, stale)))
+
+	entries, err := store.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	assert.NoError(t, store.Delete(ctx, id))
+	_, err = store.Get(ctx, id)
+	assert.True(t, errors.IsNotFound(err))
+	assert.True(t, errors.IsNotFound(store.Delete(ctx, id)))
+}
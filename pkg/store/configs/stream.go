@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// StreamingBackend is implemented by artifact backends able to transfer blob content in chunks without
+// holding it in memory; the content of blobs of other backends is buffered in memory
+type StreamingBackend interface {
+	// PutStream stores the blob content of the given size read from the reader under the given digest,
+	// unless such blob already exists
+	PutStream(ctx context.Context, digest string, r io.Reader, size int64) error
+
+	// GetStream opens the content of the blob with the given digest for reading
+	GetStream(ctx context.Context, digest string) (io.ReadCloser, error)
+}
+
+// Upload stages the artifacts of a configuration uploaded in chunks
+type Upload interface {
+	// Write appends the chunk to the content of the given artifact
+	Write(artifact string, chunk []byte) error
+
+	// Digests returns the digests of the content of the artifacts staged so far
+	Digests() Digests
+
+	// Validate checks that the staged artifacts form a valid configuration of the given kind in the manner
+	// of Validate; the content of the binary artifacts is not read
+	Validate(kind string) error
+
+	// Commit adds a new configuration with the staged artifacts and the given apply mode, which is empty
+	// for the default one, after verifying them against the expected digests, if any are given; returns
+	// DataLossError if the verification fails. The staged artifacts are discarded afterwards
+	Commit(ctx context.Context, record *provisioner.ConfigRecord, applyMode string, expected Digests) error
+
+	// CommitRevision adds the staged artifacts as a new revision of an existing configuration in the manner
+	// of ConfigStore.AddRevision, after verifying them against the expected digests, if any are given
+	CommitRevision(ctx context.Context, configID provisioner.ConfigID, expected Digests, version uint64) (*History, error)
+
+	// Abort discards the staged artifacts
+	Abort()
+}
+
+// blob is the content of an artifact to be stored along with its digest
+type blob struct {
+	digest string
+	size   int64
+	open   func() (io.ReadCloser, error)
+}
+
+// Creates blobs for the artifacts held in memory
+func newBlobs(artifacts Artifacts) map[string]*blob {
+	blobs := make(map[string]*blob, len(artifacts))
+	for artifact, data := range artifacts {
+		data := data
+		blobs[artifact] = &blob{
+			digest: Digest(data),
+			size:   int64(len(data)),
+			open: func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(data)), nil
+			},
+		}
+	}
+	return blobs
+}
+
+// Returns the sizes of the blobs keyed by artifact type
+func blobSizes(blobs map[string]*blob) map[string]int64 {
+	sizes := make(map[string]int64, len(blobs))
+	for artifact, b := range blobs {
+		sizes[artifact] = b.size
+	}
+	return sizes
+}
+
+// Stores the blob content using the backend, streaming it if the backend supports it
+func (s *atomixStore) putBlob(ctx context.Context, b *blob) error {
+	r, err := b.open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if streamer, ok := s.backend.(StreamingBackend); ok {
+		return streamer.PutStream(ctx, b.digest, r, b.size)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return s.backend.Put(ctx, b.digest, data)
+}
+
+// NewUpload starts an upload of configuration artifacts in chunks
+func (s *atomixStore) NewUpload() (Upload, error) {
+	dir, err := os.MkdirTemp("", "device-config-upload-")
+	if err != nil {
+		return nil, err
+	}
+	return &upload{store: s, dir: dir, artifacts: make(map[string]*stagedArtifact)}, nil
+}
+
+// OpenArtifact opens the content of the given artifact of the referenced configuration revision for reading
+func (s *atomixStore) OpenArtifact(ctx context.Context, ref provisioner.ConfigID, artifact string) (io.ReadCloser, string, error) {
+	configID, revision, err := ParseRef(ref)
+	if err != nil {
+		return nil, "", err
+	}
+	r, err := s.getRevision(ctx, configID, revision)
+	if err != nil {
+		return nil, "", err
+	}
+	digest, ok := r.Digests[artifact]
+	if !ok {
+		return nil, "", errors.NewNotFound("artifact %s of configuration '%s' not found", artifact, ref)
+	}
+
+	var reader io.ReadCloser
+	if streamer, ok := s.backend.(StreamingBackend); ok {
+		reader, err = streamer.GetStream(ctx, digest)
+	} else {
+		var data []byte
+		data, err = s.backend.Get(ctx, digest)
+		reader = io.NopCloser(bytes.NewReader(data))
+	}
+	if err != nil {
+		log.Errorf("Unable to open artifact: %+v", err)
+		return nil, "", err
+	}
+	return &verifyingReader{
+		reader: reader,
+		hash:   sha256.New(),
+		err:    &DataLossError{ConfigID: ref, Artifact: artifact, Digest: digest},
+	}, digest, nil
+}
+
+// verifyingReader computes the digest of the content as it is read and fails at the end of the content
+// if the digest does not match the expected one
+type verifyingReader struct {
+	reader io.ReadCloser
+	hash   hash.Hash
+	err    *DataLossError
+}
+
+func (r *verifyingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	_, _ = r.hash.Write(p[:n])
+	if err == io.EOF && digestPrefix+hex.EncodeToString(r.hash.Sum(nil)) != r.err.Digest {
+		log.Errorf("Unable to verify artifact: %+v", r.err)
+		return n, r.err
+	}
+	return n, err
+}
+
+func (r *verifyingReader) Close() error {
+	return r.reader.Close()
+}
+
+// upload stages the uploaded artifacts in temporary files, computing their digests as the chunks arrive
+type upload struct {
+	store     *atomixStore
+	dir       string
+	artifacts map[string]*stagedArtifact
+	done      bool
+}
+
+type stagedArtifact struct {
+	file *os.File
+	hash hash.Hash
+	size int64
+}
+
+func (u *upload) Write(artifact string, chunk []byte) error {
+	if u.done {
+		return errors.NewInvalid("upload has already been completed")
+	}
+	staged, ok := u.artifacts[artifact]
+	if !ok {
+		file, err := os.CreateTemp(u.dir, "artifact-*")
+		if err != nil {
+			return err
+		}
+		staged = &stagedArtifact{file: file, hash: sha256.New()}
+		u.artifacts[artifact] = staged
+	}
+	if _, err := staged.file.Write(chunk); err != nil {
+		return err
+	}
+	_, _ = staged.hash.Write(chunk)
+	staged.size += int64(len(chunk))
+	return nil
+}
+
+func (u *upload) Digests() Digests {
+	digests := make(Digests, len(u.artifacts))
+	for artifact, staged := range u.artifacts {
+		digests[artifact] = digestPrefix + hex.EncodeToString(staged.hash.Sum(nil))
+	}
+	return digests
+}
+
+func (u *upload) Validate(kind string) error {
+	if u.done {
+		return errors.NewInvalid("upload has already been completed")
+	}
+	artifacts := make(Artifacts, len(u.artifacts))
+	sizes := make(map[string]int64, len(u.artifacts))
+	for artifact, staged := range u.artifacts {
+		sizes[artifact] = staged.size
+		if artifact == provisioner.P4BinaryType {
+			continue
+		}
+		data, err := os.ReadFile(staged.file.Name())
+		if err != nil {
+			return err
+		}
+		artifacts[artifact] = data
+	}
+	return validate(kind, artifacts, sizes)
+}
+
+func (u *upload) Commit(ctx context.Context, record *provisioner.ConfigRecord, applyMode string, expected Digests) error {
+	defer u.Abort()
+	if record == nil {
+		return errors.NewInvalid("Record cannot be empty")
+	}
+	blobs, err := u.verify(record.ConfigID, expected)
+	if err != nil {
+		return err
+	}
+	return u.store.add(ctx, record, applyMode, blobs)
+}
+
+func (u *upload) CommitRevision(ctx context.Context, configID provisioner.ConfigID, expected Digests, version uint64) (*History, error) {
+	defer u.Abort()
+	blobs, err := u.verify(configID, expected)
+	if err != nil {
+		return nil, err
+	}
+	return u.store.addRevision(ctx, configID, blobs, version)
+}
+
+func (u *upload) Abort() {
+	for _, staged := range u.artifacts {
+		_ = staged.file.Close()
+	}
+	u.done = true
+	_ = os.RemoveAll(u.dir)
+}
+
+// Completes the staged artifacts and verifies them against the expected digests; returns the blobs
+// of the staged artifacts
+func (u *upload) verify(configID provisioner.ConfigID, expected Digests) (map[string]*blob, error) {
+	if u.done {
+		return nil, errors.NewInvalid("upload has already been completed")
+	}
+	digests := u.Digests()
+	for artifact, digest := range expected {
+		if digests[artifact] != digest {
+			err := &DataLossError{ConfigID: configID, Artifact: artifact, Digest: digest}
+			log.Warnf("Unable to verify uploaded artifact: %+v", err)
+			return nil, err
+		}
+	}
+
+	blobs := make(map[string]*blob, len(u.artifacts))
+	for artifact, staged := range u.artifacts {
+		if err := staged.file.Close(); err != nil {
+			return nil, err
+		}
+		path := staged.file.Name()
+		blobs[artifact] = &blob{
+			digest: digests[artifact],
+			size:   staged.size,
+			open: func() (io.ReadCloser, error) {
+				return os.Open(path)
+			},
+		}
+	}
+	return blobs, nil
+}
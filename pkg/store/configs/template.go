@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"bytes"
+	"encoding/json"
+	"text/template"
+
+	"github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// ChassisTemplateType is the type of the chassis configuration artifact holding a Go template of the chassis
+// config, which is rendered for each device before it is applied; chassis configurations have either this
+// artifact or the literal chassis artifact
+const ChassisTemplateType = "chassis-template"
+
+// ChassisTemplateData is the data with which chassis config templates are rendered
+type ChassisTemplateData struct {
+	// ID is the ID of the device topo entity
+	ID topo.ID
+	// Labels are the labels of the device topo entity
+	Labels map[string]string
+	// StratumAgents is the StratumAgents aspect of the device topo entity, if any
+	StratumAgents *topo.StratumAgents
+	// Aspects are all aspects of the device topo entity, decoded from JSON and keyed by their type
+	Aspects map[string]interface{}
+}
+
+// NewChassisTemplateData returns the data for rendering chassis config templates for the given device
+func NewChassisTemplateData(object *topo.Object) *ChassisTemplateData {
+	data := &ChassisTemplateData{
+		ID:      object.ID,
+		Labels:  object.Labels,
+		Aspects: make(map[string]interface{}),
+	}
+	stratumAgents := &topo.StratumAgents{}
+	if err := object.GetAspect(stratumAgents); err == nil {
+		data.StratumAgents = stratumAgents
+	}
+	for aspectType := range object.Aspects {
+		var value interface{}
+		if err := json.Unmarshal(object.GetAspectBytes(aspectType), &value); err == nil {
+			data.Aspects[aspectType] = value
+		}
+	}
+	return data
+}
+
+// RenderChassisConfig renders the chassis config template for the given device; returns an invalid argument
+// error if the template cannot be rendered for the device or if the rendered config is malformed
+func RenderChassisConfig(text []byte, object *topo.Object) ([]byte, error) {
+	tmpl, err := parseChassisTemplate(text)
+	if err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err = tmpl.Execute(buf, NewChassisTemplateData(object)); err != nil {
+		return nil, errors.NewInvalid("rendering %s artifact for %s: %v", ChassisTemplateType, object.ID, err)
+	}
+	if err = parseTextMessage(buf.String(), chassisConfigFields); err != nil {
+		return nil, errors.NewInvalid("%s artifact rendered for %s: %v", ChassisTemplateType, object.ID, err)
+	}
+	return buf.Bytes(), nil
+}
+
+func parseChassisTemplate(text []byte) (*template.Template, error) {
+	tmpl, err := template.New(ChassisTemplateType).Option("missingkey=error").Parse(string(text))
+	if err != nil {
+		return nil, errors.NewInvalid("%s artifact: %v", ChassisTemplateType, err)
+	}
+	return tmpl, nil
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const testChassisTemplate = `
+description: "{{ .ID }}"
+nodes {
+  id: {{ .StratumAgents.DeviceID }}
+  name: "{{ .ID }}"
+}
+singleton_ports {
+  id: 1
+  speed_bps: {{ .Labels.speed }}
+  node: {{ .StratumAgents.DeviceID }}
+}
+`
+
+func TestRenderChassisConfig(t *testing.T) {
+	object := &topo.Object{ID: "leaf1", Labels: map[string]string{"speed": "100000000000"}}
+	assert.NoError(t, object.SetAspect(&topo.StratumAgents{DeviceID: 7}))
+
+	config, err := RenderChassisConfig([]byte(testChassisTemplate), object)
+	assert.NoError(t, err)
+	assert.Contains(t, string(config), `description: "leaf1"`)
+	assert.Contains(t, string(config), "id: 7")
+	assert.Contains(t, string(config), "speed_bps: 100000000000")
+
+	// missing labels fail the rendering
+	object.Labels = nil
+	_, err = RenderChassisConfig([]byte(testChassisTemplate), object)
+	assert.True(t, errors.IsInvalid(err))
+
+	// so does a malformed rendered config
+	object.Labels = map[string]string{"speed": "{"}
+	_, err = RenderChassisConfig([]byte(testChassisTemplate), object)
+	assert.True(t, errors.IsInvalid(err))
+
+	// templates are validated when added
+	assert.NoError(t, Validate(ChassisConfigKind, Artifacts{ChassisTemplateType: []byte(testChassisTemplate)}))
+	err = Validate(ChassisConfigKind, Artifacts{ChassisTemplateType: []byte("nodes {\n  id: {{ .ID }\n}")})
+	assert.True(t, errors.IsInvalid(err))
+	assert.Contains(t, err.Error(), ":2:")
+	err = Validate(ChassisConfigKind, Artifacts{ChassisTemplateType: []byte(testChassisTemplate),
+		provisioner.ChassisType: []byte(testChassisConfig)})
+	assert.True(t, errors.IsInvalid(err))
+}
@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// textScanner checks the syntax of protobuf text format messages whose schema is not available to the provisioner,
+// such as the Stratum chassis configurations; errors carry the line and column of the offending token
+type textScanner struct {
+	text string
+	pos  int
+	line int
+	col  int
+	// out receives the normalized message, if set
+	out *strings.Builder
+}
+
+// Parses the text format message, requiring its top-level fields to be among the given ones
+func parseTextMessage(text string, fields map[string]bool) error {
+	s := &textScanner{text: text, line: 1, col: 1}
+	return s.message("", fields)
+}
+
+// NormalizeTextMessage returns the given protobuf text format message in a normalized form, without comments,
+// optional separators and insignificant whitespace, with angle brackets replaced by braces and lists expanded into
+// repeated fields, so that messages differing only in their formatting have identical normalized forms
+func NormalizeTextMessage(text []byte) ([]byte, error) {
+	out := &strings.Builder{}
+	s := &textScanner{text: string(text), line: 1, col: 1, out: out}
+	if err := s.message("", nil); err != nil {
+		return nil, err
+	}
+	return []byte(out.String()), nil
+}
+
+// Parses message fields until the given closing delimiter, or the end of the text if none
+func (s *textScanner) message(closing string, fields map[string]bool) error {
+	for {
+		s.skip()
+		if s.pos == len(s.text) {
+			if closing != "" {
+				return s.errorf("unexpected end of text; expected '%s'", closing)
+			}
+			return nil
+		}
+		if closing != "" && strings.HasPrefix(s.text[s.pos:], closing) {
+			s.advance(1)
+			s.emit("}\n")
+			return nil
+		}
+		if err := s.field(fields); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *textScanner) field(fields map[string]bool) error {
+	line, col := s.line, s.col
+	var name string
+	if s.peek() == '[' {
+		// extension or Any type URL
+		end := strings.IndexByte(s.text[s.pos:], ']')
+		if end < 0 {
+			return s.errorf("unterminated field name")
+		}
+		name = s.text[s.pos : s.pos+end+1]
+		s.advance(end + 1)
+	} else {
+		name = s.identifier()
+		if name == "" {
+			return s.errorf("expected field name; found '%c'", s.peek())
+		}
+	}
+	if fields != nil && !fields[name] {
+		return fmt.Errorf("(line %d:%d): unknown field: %s", line, col, name)
+	}
+
+	s.skip()
+	if s.peek() == ':' {
+		s.advance(1)
+		s.skip()
+		if s.peek() == '[' {
+			if err := s.list(name); err != nil {
+				return err
+			}
+		} else {
+			s.emit(name)
+			if err := s.value(); err != nil {
+				return err
+			}
+		}
+	} else {
+		s.emit(name)
+		if err := s.submessage(); err != nil {
+			return err
+		}
+	}
+
+	s.skip()
+	if c := s.peek(); c == ',' || c == ';' {
+		s.advance(1)
+	}
+	return nil
+}
+
+// Parses the list of values of the named field; the values are emitted as repeated fields
+func (s *textScanner) list(name string) error {
+	s.advance(1)
+	for {
+		s.skip()
+		if s.peek() == ']' {
+			s.advance(1)
+			return nil
+		}
+		s.emit(name)
+		if err := s.value(); err != nil {
+			return err
+		}
+		s.skip()
+		switch s.peek() {
+		case ',':
+			s.advance(1)
+		case ']':
+		default:
+			return s.errorf("expected ',' or ']' in list")
+		}
+	}
+}
+
+func (s *textScanner) value() error {
+	switch c := s.peek(); {
+	case c == '{' || c == '<':
+		return s.submessage()
+	case c == '"' || c == '\'':
+		// adjacent strings are concatenated
+		value := &strings.Builder{}
+		for c == '"' || c == '\'' {
+			start := s.pos
+			if err := s.quoted(c); err != nil {
+				return err
+			}
+			doubleQuoted(value, s.text[start+1:s.pos-1], c)
+			s.skip()
+			c = s.peek()
+		}
+		s.emit(": \"", value.String(), "\"\n")
+		return nil
+	default:
+		sign := ""
+		if c == '-' {
+			sign = "-"
+			s.advance(1)
+			s.skip()
+		}
+		scalar := s.scalar()
+		if scalar == "" {
+			return s.errorf("expected value")
+		}
+		s.emit(": ", sign, scalar, "\n")
+		return nil
+	}
+}
+
+func (s *textScanner) submessage() error {
+	switch s.peek() {
+	case '{':
+		s.advance(1)
+		s.emit(" {\n")
+		return s.message("}", nil)
+	case '<':
+		s.advance(1)
+		s.emit(" {\n")
+		return s.message(">", nil)
+	}
+	return s.errorf("expected ':', '{' or '<'")
+}
+
+func (s *textScanner) quoted(quote byte) error {
+	line, col := s.line, s.col
+	s.advance(1)
+	for s.pos < len(s.text) {
+		switch s.text[s.pos] {
+		case quote:
+			s.advance(1)
+			return nil
+		case '\\':
+			s.advance(2)
+		case '\n':
+			return fmt.Errorf("(line %d:%d): unterminated string", line, col)
+		default:
+			s.advance(1)
+		}
+	}
+	return fmt.Errorf("(line %d:%d): unterminated string", line, col)
+}
+
+// Writes the content of a string quoted with the given quote as the content of a double-quoted string
+func doubleQuoted(out *strings.Builder, content string, quote byte) {
+	if quote == '"' {
+		out.WriteString(content)
+		return
+	}
+	for i := 0; i < len(content); i++ {
+		switch c := content[i]; {
+		case c == '\\' && i+1 < len(content) && content[i+1] == '\'':
+			out.WriteByte('\'')
+			i++
+		case c == '\\' && i+1 < len(content):
+			out.WriteString(content[i : i+2])
+			i++
+		case c == '"':
+			out.WriteString(`\"`)
+		default:
+			out.WriteByte(c)
+		}
+	}
+}
+
+func (s *textScanner) identifier() string {
+	start := s.pos
+	for s.pos < len(s.text) && isIdentifierChar(s.text[s.pos], s.pos == start) {
+		s.advance(1)
+	}
+	return s.text[start:s.pos]
+}
+
+// Scans an identifier, enum value or number, including floats with exponents
+func (s *textScanner) scalar() string {
+	start := s.pos
+	for s.pos < len(s.text) {
+		c := s.text[s.pos]
+		if !isIdentifierChar(c, false) && c != '.' && !((c == '+' || c == '-') && s.pos > start && (s.text[s.pos-1] == 'e' || s.text[s.pos-1] == 'E')) {
+			break
+		}
+		s.advance(1)
+	}
+	return s.text[start:s.pos]
+}
+
+func isIdentifierChar(c byte, first bool) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || (!first && '0' <= c && c <= '9')
+}
+
+// Skips whitespace and comments
+func (s *textScanner) skip() {
+	for s.pos < len(s.text) {
+		switch s.text[s.pos] {
+		case ' ', '\t', '\r', '\n', '\f', '\v':
+			s.advance(1)
+		case '#':
+			for s.pos < len(s.text) && s.text[s.pos] != '\n' {
+				s.advance(1)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// Writes the given parts of the normalized message, if it is being normalized
+func (s *textScanner) emit(parts ...string) {
+	if s.out == nil {
+		return
+	}
+	for _, part := range parts {
+		s.out.WriteString(part)
+	}
+}
+
+func (s *textScanner) peek() byte {
+	if s.pos < len(s.text) {
+		return s.text[s.pos]
+	}
+	return 0
+}
+
+func (s *textScanner) advance(n int) {
+	for ; n > 0 && s.pos < len(s.text); n-- {
+		if s.text[s.pos] == '\n' {
+			s.line++
+			s.col = 1
+		} else {
+			s.col++
+		}
+		s.pos++
+	}
+}
+
+func (s *textScanner) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("(line %d:%d): %s", s.line, s.col, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"io"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// Usage is the size of the configuration inventory
+type Usage struct {
+	// Configs is the number of configurations of each kind
+	Configs map[string]int
+	// Revisions is the number of revisions of all configurations
+	Revisions int
+	// Artifacts is the number of distinct artifacts kept by the artifact backend; artifacts with identical
+	// content are kept only once
+	Artifacts int
+	// ArtifactBytes is the total size of the distinct artifacts; artifacts of revisions which do not record
+	// their sizes are not accounted for
+	ArtifactBytes int64
+}
+
+// Usage returns the size of the configuration inventory
+func (s *atomixStore) Usage(ctx context.Context) (*Usage, error) {
+	usage := &Usage{Configs: map[string]int{PipelineConfigKind: 0, ChassisConfigKind: 0}}
+	configs, err := s.configs.List(ctx)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	for {
+		entry, err := configs.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.FromAtomix(err)
+		}
+		usage.Configs[entry.Value.Kind]++
+	}
+
+	revisions, err := s.revisions.List(ctx)
+	if err != nil {
+		return nil, errors.FromAtomix(err)
+	}
+	sizes := make(map[string]int64)
+	for {
+		entry, err := revisions.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.FromAtomix(err)
+		}
+		for _, revision := range entry.Value.Revisions {
+			usage.Revisions++
+			for artifact, digest := range revision.Digests {
+				if size, ok := revision.Sizes[artifact]; ok || sizes[digest] == 0 {
+					sizes[digest] = size
+				}
+			}
+		}
+	}
+	usage.Artifacts = len(sizes)
+	for _, size := range sizes {
+		usage.ArtifactBytes += size
+	}
+	return usage, nil
+}
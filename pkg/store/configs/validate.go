@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"fmt"
+
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	p4info "github.com/p4lang/p4runtime/go/p4/config/v1"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// chassisConfigFields are the top-level fields of the Stratum ChassisConfig message
+var chassisConfigFields = map[string]bool{
+	"description":                true,
+	"chassis":                    true,
+	"nodes":                      true,
+	"singleton_ports":            true,
+	"trunk_ports":                true,
+	"port_groups":                true,
+	"vendor_config":              true,
+	"optical_network_interfaces": true,
+}
+
+// Validate checks that the artifacts form a valid configuration of the given kind; configurations of other
+// than the pipeline and chassis kinds are not checked. Rejections are reported as invalid argument errors
+// which point to the location of the problem.
+func Validate(kind string, artifacts Artifacts) error {
+	sizes := make(map[string]int64, len(artifacts))
+	for artifact, data := range artifacts {
+		sizes[artifact] = int64(len(data))
+	}
+	return validate(kind, artifacts, sizes)
+}
+
+// Validates the artifacts of the given sizes; the content of the binary artifacts, which is not checked,
+// need not be given
+func validate(kind string, artifacts Artifacts, sizes map[string]int64) error {
+	switch kind {
+	case PipelineConfigKind:
+		return validatePipelineConfig(artifacts, sizes)
+	case ChassisConfigKind:
+		return checkChassisConfigSyntax(artifacts, sizes)
+	}
+	return nil
+}
+
+func validatePipelineConfig(artifacts Artifacts, sizes map[string]int64) error {
+	if err := requireArtifacts(sizes, provisioner.P4InfoType, provisioner.P4BinaryType); err != nil {
+		return err
+	}
+	info := &p4info.P4Info{}
+	if err := prototext.Unmarshal(artifacts[provisioner.P4InfoType], info); err != nil {
+		return errors.NewInvalid("%s artifact: %v", provisioner.P4InfoType, err)
+	}
+	if err := validateP4InfoIDs(info); err != nil {
+		return errors.NewInvalid("%s artifact: %v", provisioner.P4InfoType, err)
+	}
+	return nil
+}
+
+// Checks that the pipeline apply mode is supported; the empty mode stands for VerifyAndCommitMode
+func validateApplyMode(mode string) error {
+	switch mode {
+	case "", VerifyAndCommitMode, ReconcileAndCommitMode, VerifyThenCommitMode:
+		return nil
+	}
+	return errors.NewInvalid("unsupported pipeline apply mode '%s'", mode)
+}
+
+// Checks only the syntax of the chassis artifacts, as the Stratum ChassisConfig message is not available to
+// unmarshal them into; the chassis config must be well-formed proto text with known top-level fields, but its
+// nested fields and values are left to the device to validate
+func checkChassisConfigSyntax(artifacts Artifacts, sizes map[string]int64) error {
+	if text, ok := artifacts[ChassisTemplateType]; ok {
+		if _, ok = artifacts[provisioner.ChassisType]; ok {
+			return errors.NewInvalid("artifacts %s and %s are mutually exclusive", provisioner.ChassisType, ChassisTemplateType)
+		}
+		_, err := parseChassisTemplate(text)
+		return err
+	}
+	if err := requireArtifacts(sizes, provisioner.ChassisType); err != nil {
+		return err
+	}
+	if err := parseTextMessage(string(artifacts[provisioner.ChassisType]), chassisConfigFields); err != nil {
+		return errors.NewInvalid("%s artifact: %v", provisioner.ChassisType, err)
+	}
+	return nil
+}
+
+func requireArtifacts(sizes map[string]int64, types ...string) error {
+	for _, t := range types {
+		if sizes[t] == 0 {
+			return errors.NewInvalid("required artifact %s is missing", t)
+		}
+	}
+	return nil
+}
+
+// Checks that all P4Info entities have IDs unique across the P4Info and prefixed for their entity type,
+// and that the tables refer only to existing actions and match fields with unique IDs
+func validateP4InfoIDs(info *p4info.P4Info) error {
+	ids := make(map[uint32]string)
+	check := func(preamble *p4info.Preamble, prefix p4info.P4Ids_Prefix) error {
+		if preamble == nil {
+			return fmt.Errorf("%s entity without preamble", prefix)
+		}
+		if preamble.Id == 0 {
+			return fmt.Errorf("%s %s has no ID", prefix, preamble.Name)
+		}
+		if p4info.P4Ids_Prefix(preamble.Id>>24) != prefix {
+			return fmt.Errorf("%s %s has ID 0x%08x without the %s prefix", prefix, preamble.Name, preamble.Id, prefix)
+		}
+		if name, ok := ids[preamble.Id]; ok {
+			return fmt.Errorf("%s %s has ID 0x%08x already used by %s", prefix, preamble.Name, preamble.Id, name)
+		}
+		ids[preamble.Id] = preamble.Name
+		return nil
+	}
+
+	for _, action := range info.Actions {
+		if err := check(action.Preamble, p4info.P4Ids_ACTION); err != nil {
+			return err
+		}
+	}
+	for _, table := range info.Tables {
+		if err := check(table.Preamble, p4info.P4Ids_TABLE); err != nil {
+			return err
+		}
+	}
+	for _, profile := range info.ActionProfiles {
+		if err := check(profile.Preamble, p4info.P4Ids_ACTION_PROFILE); err != nil {
+			return err
+		}
+	}
+	for _, counter := range info.Counters {
+		if err := check(counter.Preamble, p4info.P4Ids_COUNTER); err != nil {
+			return err
+		}
+	}
+	for _, counter := range info.DirectCounters {
+		if err := check(counter.Preamble, p4info.P4Ids_DIRECT_COUNTER); err != nil {
+			return err
+		}
+	}
+	for _, meter := range info.Meters {
+		if err := check(meter.Preamble, p4info.P4Ids_METER); err != nil {
+			return err
+		}
+	}
+	for _, meter := range info.DirectMeters {
+		if err := check(meter.Preamble, p4info.P4Ids_DIRECT_METER); err != nil {
+			return err
+		}
+	}
+	for _, metadata := range info.ControllerPacketMetadata {
+		if err := check(metadata.Preamble, p4info.P4Ids_CONTROLLER_HEADER); err != nil {
+			return err
+		}
+	}
+	for _, valueSet := range info.ValueSets {
+		if err := check(valueSet.Preamble, p4info.P4Ids_VALUE_SET); err != nil {
+			return err
+		}
+	}
+	for _, register := range info.Registers {
+		if err := check(register.Preamble, p4info.P4Ids_REGISTER); err != nil {
+			return err
+		}
+	}
+	for _, digest := range info.Digests {
+		if err := check(digest.Preamble, p4info.P4Ids_DIGEST); err != nil {
+			return err
+		}
+	}
+
+	for _, table := range info.Tables {
+		fields := make(map[uint32]bool)
+		for _, field := range table.MatchFields {
+			if field.Id == 0 || fields[field.Id] {
+				return fmt.Errorf("table %s has match field %s with missing or duplicate ID %d", table.Preamble.Name, field.Name, field.Id)
+			}
+			fields[field.Id] = true
+		}
+		refs := make([]uint32, 0, len(table.ActionRefs)+1)
+		for _, ref := range table.ActionRefs {
+			refs = append(refs, ref.Id)
+		}
+		if table.ConstDefaultActionId != 0 {
+			refs = append(refs, table.ConstDefaultActionId)
+		}
+		for _, id := range refs {
+			if _, ok := ids[id]; !ok || p4info.P4Ids_Prefix(id>>24) != p4info.P4Ids_ACTION {
+				return fmt.Errorf("table %s refers to unknown action 0x%08x", table.Preamble.Name, id)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const testP4Info = `
+tables {
+  preamble { id: 33554433 name: "ingress.acl" }
+  match_fields { id: 1 name: "hdr.ethernet.ether_type" bitwidth: 16 match_type: TERNARY }
+  match_fields { id: 2 name: "standard_metadata.ingress_port" bitwidth: 9 match_type: TERNARY }
+  action_refs { id: 16777217 }
+  const_default_action_id: 16777217
+  size: 1024
+}
+actions {
+  preamble { id: 16777217 name: "ingress.drop" }
+}
+`
+
+const testChassisConfig = `
+# chassis config for a single node switch
+description: "leaf switch"
+chassis {
+  platform: PLT_GENERIC_BAREFOOT_TOFINO
+  name: "leaf1"
+}
+nodes {
+  id: 1
+  slot: 1
+  index: 1
+}
+singleton_ports {
+  id: 1
+  name: "1/0"
+  slot: 1
+  port: 1
+  speed_bps: 100000000000
+  config_params { admin_state: ADMIN_STATE_ENABLED }
+  node: 1
+}
+`
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(PipelineConfigKind, Artifacts{
+		provisioner.P4InfoType: []byte(testP4Info), provisioner.P4BinaryType: []byte("binary")}))
+	assert.NoError(t, Validate(ChassisConfigKind, Artifacts{provisioner.ChassisType: []byte(testChassisConfig)}))
+	assert.NoError(t, Validate("other", Artifacts{"json": []byte("{")}))
+
+	// missing artifacts
+	err := Validate(PipelineConfigKind, Artifacts{provisioner.P4InfoType: []byte(testP4Info)})
+	assert.True(t, errors.IsInvalid(err))
+	assert.Contains(t, err.Error(), provisioner.P4BinaryType)
+	err = Validate(ChassisConfigKind, Artifacts{"json": []byte("{}")})
+	assert.True(t, errors.IsInvalid(err))
+
+	// malformed p4info
+	err = Validate(PipelineConfigKind, Artifacts{
+		provisioner.P4InfoType: []byte("tables {\n  preamble { idd: 1 }\n}"), provisioner.P4BinaryType: []byte("binary")})
+	assert.True(t, errors.IsInvalid(err))
+	assert.Contains(t, err.Error(), "line 2:")
+
+	// inconsistent p4info IDs
+	err = Validate(PipelineConfigKind, Artifacts{
+		provisioner.P4InfoType: []byte(`actions { preamble { id: 33554433 name: "drop" } }`), provisioner.P4BinaryType: []byte("binary")})
+	assert.True(t, errors.IsInvalid(err))
+	assert.Contains(t, err.Error(), "prefix")
+	err = Validate(PipelineConfigKind, Artifacts{
+		provisioner.P4InfoType: []byte(testP4Info + `actions { preamble { id: 16777217 name: "nop" } }`), provisioner.P4BinaryType: []byte("binary")})
+	assert.True(t, errors.IsInvalid(err))
+	assert.Contains(t, err.Error(), "ingress.drop")
+	err = Validate(PipelineConfigKind, Artifacts{
+		provisioner.P4InfoType: []byte(`tables { preamble { id: 33554433 name: "acl" } action_refs { id: 16777218 } }`), provisioner.P4BinaryType: []byte("binary")})
+	assert.True(t, errors.IsInvalid(err))
+	assert.Contains(t, err.Error(), "unknown action")
+
+	// unsupported apply mode
+	assert.NoError(t, validateApplyMode(VerifyThenCommitMode))
+	assert.True(t, errors.IsInvalid(validateApplyMode("COMMIT")))
+
+	// malformed chassis configs
+	err = Validate(ChassisConfigKind, Artifacts{provisioner.ChassisType: []byte("chassis {\n  name: \"leaf1\"\n")})
+	assert.True(t, errors.IsInvalid(err))
+	assert.Contains(t, err.Error(), "line 3:1")
+	err = Validate(ChassisConfigKind, Artifacts{provisioner.ChassisType: []byte("chassis {}\nnode {\n  id: 1\n}")})
+	assert.True(t, errors.IsInvalid(err))
+	assert.Contains(t, err.Error(), "line 2:1")
+	assert.Contains(t, err.Error(), "node")
+	err = Validate(ChassisConfigKind, Artifacts{provisioner.ChassisType: []byte("chassis {\n  name: \"leaf1\n}")})
+	assert.True(t, errors.IsInvalid(err))
+	assert.Contains(t, err.Error(), "line 2:9")
+	err = Validate(ChassisConfigKind, Artifacts{provisioner.ChassisType: []byte("nodes { id 1 }")})
+	assert.True(t, errors.IsInvalid(err))
+	assert.Contains(t, err.Error(), "line 1:12")
+}
+
+func TestNormalizeTextMessage(t *testing.T) {
+	normalized, err := NormalizeTextMessage([]byte("description: \"leaf\" # comment\nnodes < id: 1, flags: [1, -2] >;\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "description: \"leaf\"\nnodes {\nid: 1\nflags: 1\nflags: -2\n}\n", string(normalized))
+
+	// messages differing only in their formatting have identical normalized forms
+	other, err := NormalizeTextMessage([]byte("description:\"leaf\"\nnodes{id:1 flags:1 flags:-2}"))
+	assert.NoError(t, err)
+	assert.Equal(t, normalized, other)
+
+	_, err = NormalizeTextMessage([]byte("nodes {"))
+	assert.Error(t, err)
+}
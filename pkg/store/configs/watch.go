@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	_map "github.com/atomix/go-sdk/pkg/primitive/map"
+	"github.com/onosproject/onos-api/go/onos/provisioner"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// EventType is the type of configuration event
+type EventType int
+
+const (
+	// EventNone indicates a configuration which existed when the watch started and is being replayed
+	EventNone EventType = iota
+	// EventAdded indicates a newly added configuration
+	EventAdded
+	// EventUpdated indicates a configuration whose record changed, e.g. due to a new current revision
+	EventUpdated
+	// EventDeleted indicates a deleted configuration
+	EventDeleted
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventNone:
+		return "NONE"
+	case EventAdded:
+		return "ADDED"
+	case EventUpdated:
+		return "UPDATED"
+	case EventDeleted:
+		return "DELETED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Event is a configuration event
+type Event struct {
+	Type   EventType
+	Record *provisioner.ConfigRecord
+}
+
+// Watch streams events for configurations of the requested kind, or of all kinds if the kind is empty; if replay
+// is requested, the existing configurations are streamed as EventNone events first. This is a non-blocking method;
+// the channel is closed once the context is done or the event streams fail.
+func (s *atomixStore) Watch(ctx context.Context, kind string, ch chan<- Event, replay bool) error {
+	// subscribe to events before listing the existing entries, so that no changes are missed in between
+	events, err := s.configs.Events(ctx)
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	// changes of the current revision leave the record intact, so they are observed on the revisions
+	historyEvents, err := s.revisions.Events(ctx)
+	if err != nil {
+		return errors.FromAtomix(err)
+	}
+	var entries _map.EntryStream[provisioner.ConfigID, *provisioner.ConfigRecord]
+	if replay {
+		if entries, err = s.configs.List(ctx); err != nil {
+			return errors.FromAtomix(err)
+		}
+	}
+
+	w := &watcher{ctx: ctx, kind: kind, ch: ch}
+	go func() {
+		defer close(ch)
+		if entries != nil && !w.replay(entries) {
+			return
+		}
+
+		wg := &sync.WaitGroup{}
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			w.watchRecords(events)
+		}()
+		go func() {
+			defer wg.Done()
+			w.watchHistories(historyEvents, s.configs)
+		}()
+		wg.Wait()
+	}()
+	return nil
+}
+
+// watcher forwards configuration events of the watched kind to the watch channel
+type watcher struct {
+	ctx  context.Context
+	kind string
+	ch   chan<- Event
+}
+
+// Sends the event unless it is filtered out; returns false if the watch is done
+func (w *watcher) send(eventType EventType, record *provisioner.ConfigRecord) bool {
+	if len(w.kind) > 0 && record.Kind != w.kind {
+		return true
+	}
+	select {
+	case w.ch <- Event{Type: eventType, Record: record}:
+		return true
+	case <-w.ctx.Done():
+		return false
+	}
+}
+
+func (w *watcher) replay(entries _map.EntryStream[provisioner.ConfigID, *provisioner.ConfigRecord]) bool {
+	for {
+		entry, err := entries.Next()
+		if err == io.EOF {
+			return true
+		}
+		if err != nil {
+			log.Warnf("Unable to replay configurations: %+v", errors.FromAtomix(err))
+			return false
+		}
+		if !w.send(EventNone, entry.Value) {
+			return false
+		}
+	}
+}
+
+func (w *watcher) watchRecords(events _map.EventStream[provisioner.ConfigID, *provisioner.ConfigRecord]) {
+	ok := true
+	for ok {
+		event, err := events.Next()
+		if err != nil {
+			w.failed(err)
+			return
+		}
+		switch e := event.(type) {
+		case *_map.Inserted[provisioner.ConfigID, *provisioner.ConfigRecord]:
+			ok = w.send(EventAdded, e.Entry.Value)
+		case *_map.Updated[provisioner.ConfigID, *provisioner.ConfigRecord]:
+			ok = w.send(EventUpdated, e.Entry.Value)
+		case *_map.Removed[provisioner.ConfigID, *provisioner.ConfigRecord]:
+			ok = w.send(EventDeleted, e.Entry.Value)
+		}
+	}
+}
+
+func (w *watcher) watchHistories(events _map.EventStream[provisioner.ConfigID, *History], configs _map.Map[provisioner.ConfigID, *provisioner.ConfigRecord]) {
+	ok := true
+	for ok {
+		event, err := events.Next()
+		if err != nil {
+			w.failed(err)
+			return
+		}
+		// histories are inserted and removed along with their records; only their updates are of interest
+		e, isUpdate := event.(*_map.Updated[provisioner.ConfigID, *History])
+		if !isUpdate || e.Entry.Value.Current == e.PrevEntry.Value.Current {
+			continue
+		}
+		entry, err := configs.Get(w.ctx, e.Entry.Key)
+		if err != nil {
+			continue
+		}
+		ok = w.send(EventUpdated, entry.Value)
+	}
+}
+
+func (w *watcher) failed(err error) {
+	if err != io.EOF && w.ctx.Err() == nil {
+		log.Warnf("Configuration event stream failed: %+v", errors.FromAtomix(err))
+	}
+}
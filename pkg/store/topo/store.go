@@ -8,6 +8,8 @@ package topo
 import (
 	"context"
 	"io"
+	"sync/atomic"
+	"time"
 
 	"github.com/onosproject/onos-lib-go/pkg/grpc/retry"
 	"google.golang.org/grpc/codes"
@@ -38,10 +40,24 @@ type Store interface {
 	// Delete deletes a topology object using the given ID
 	Delete(ctx context.Context, object *topoapi.Object) error
 
-	// Watch watches topology events
+	// Watch watches topology events, starting with the replay of the existing objects; if the watch stream breaks,
+	// the watch is re-established with backoff, again starting with a full replay. This is a non-blocking method
+	// and the channel is closed once the context is done
 	Watch(ctx context.Context, ch chan<- topoapi.Event, filters *topoapi.Filters) error
+
+	// WatchUntracked watches topology events in the manner of Watch on behalf of a northbound client; unlike the
+	// watches of the provisioner itself, the watch does not count toward the lost watches while re-established
+	WatchUntracked(ctx context.Context, ch chan<- topoapi.Event, filters *topoapi.Filters) error
+
+	// LostWatches returns the number of watches whose stream broke and which are not re-established yet
+	LostWatches() int
 }
 
+const (
+	watchInitialBackoff = time.Second
+	watchMaxBackoff     = 30 * time.Second
+)
+
 // NewStore creates a new topology store
 func NewStore(topoEndpoint string, opts ...grpc.DialOption) (Store, error) {
 	if len(opts) == 0 {
@@ -63,6 +79,7 @@ func NewStore(topoEndpoint string, opts ...grpc.DialOption) (Store, error) {
 
 type topoClient struct {
 	client topoapi.TopoClient
+	lost   atomic.Int32
 }
 
 // Create creates topology object in topo store
@@ -143,30 +160,101 @@ func (s *topoClient) Delete(ctx context.Context, object *topoapi.Object) error {
 	return nil
 }
 
-// Watch watches topology events
+// Watch watches topology events, re-establishing the watch whenever its stream breaks
 func (s *topoClient) Watch(ctx context.Context, ch chan<- topoapi.Event, filters *topoapi.Filters) error {
-	stream, err := s.client.Watch(ctx, &topoapi.WatchRequest{
-		Noreplay: false,
-		Filters:  filters,
-	})
+	return s.watchEvents(ctx, ch, filters, &s.lost)
+}
+
+// WatchUntracked watches topology events like Watch, without counting the watch as lost while re-established
+func (s *topoClient) WatchUntracked(ctx context.Context, ch chan<- topoapi.Event, filters *topoapi.Filters) error {
+	return s.watchEvents(ctx, ch, filters, nil)
+}
+
+// Watches topology events, re-establishing the watch whenever its stream breaks; the watch counts toward the
+// given number of lost watches meanwhile, if any
+func (s *topoClient) watchEvents(ctx context.Context, ch chan<- topoapi.Event, filters *topoapi.Filters, lost *atomic.Int32) error {
+	stream, err := s.watch(ctx, filters)
 	if err != nil {
 		return errors.FromGRPC(err)
 	}
 	go func() {
 		defer close(ch)
+		backoff := watchInitialBackoff
 		for {
-			resp, err := stream.Recv()
-			if err == io.EOF {
-				break
+			received, err := receive(ctx, stream, ch)
+			if ctx.Err() != nil {
+				return
 			}
-			if err != nil {
-				log.Warn(err)
-				break
+			// streams breaking right after being established do not reset the backoff
+			if received {
+				backoff = watchInitialBackoff
+			}
+			log.Warnf("Topo watch stream broke: %v", err)
+			if stream = s.rewatch(ctx, filters, &backoff, lost); stream == nil {
+				return
 			}
-			ch <- resp.Event
+			log.Info("Topo watch re-established")
 		}
 	}()
 	return nil
 }
 
+// Re-establishes a broken watch, retrying with exponential backoff; the watch counts toward the given number
+// of lost watches meanwhile, if any. Returns nil if the context is done first
+func (s *topoClient) rewatch(ctx context.Context, filters *topoapi.Filters, backoff *time.Duration, lost *atomic.Int32) topoapi.Topo_WatchClient {
+	if lost != nil {
+		lost.Add(1)
+		defer lost.Add(-1)
+	}
+	for {
+		select {
+		case <-time.After(*backoff):
+		case <-ctx.Done():
+			return nil
+		}
+		if *backoff *= 2; *backoff > watchMaxBackoff {
+			*backoff = watchMaxBackoff
+		}
+		stream, err := s.watch(ctx, filters)
+		if err == nil {
+			return stream
+		}
+		log.Warnf("Unable to re-establish topo watch: %v", err)
+	}
+}
+
+// Opens a watch stream replaying the existing objects first
+func (s *topoClient) watch(ctx context.Context, filters *topoapi.Filters) (topoapi.Topo_WatchClient, error) {
+	return s.client.Watch(ctx, &topoapi.WatchRequest{
+		Noreplay: false,
+		Filters:  filters,
+	})
+}
+
+// Passes the events of the watch stream to the channel until the stream breaks or the context is done; returns
+// whether any event was received and the error which broke the stream
+func receive(ctx context.Context, stream topoapi.Topo_WatchClient, ch chan<- topoapi.Event) (bool, error) {
+	received := false
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				err = errors.NewUnavailable("topo closed the watch stream")
+			}
+			return received, err
+		}
+		received = true
+		select {
+		case ch <- resp.Event:
+		case <-ctx.Done():
+			return received, ctx.Err()
+		}
+	}
+}
+
+// LostWatches returns the number of watches being re-established
+func (s *topoClient) LostWatches() int {
+	return int(s.lost.Load())
+}
+
 var _ Store = &topoClient{}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package topo
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// watchStream replays the given events and then breaks, unless it is the last stream, which blocks instead
+type watchStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	events []topoapi.Event
+	last   bool
+}
+
+func (s *watchStream) Recv() (*topoapi.WatchResponse, error) {
+	if len(s.events) > 0 {
+		event := s.events[0]
+		s.events = s.events[1:]
+		return &topoapi.WatchResponse{Event: event}, nil
+	}
+	if s.last {
+		<-s.ctx.Done()
+		return nil, s.ctx.Err()
+	}
+	return nil, errors.NewUnavailable("stream broke")
+}
+
+// watchClient serves the watches with the given streams in turn
+type watchClient struct {
+	topoapi.TopoClient
+	mu      sync.Mutex
+	streams []*watchStream
+	lost    chan int
+	store   *topoClient
+}
+
+func (c *watchClient) Watch(ctx context.Context, in *topoapi.WatchRequest, opts ...grpc.CallOption) (topoapi.Topo_WatchClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.store != nil {
+		c.lost <- c.store.LostWatches()
+	}
+	stream := c.streams[0]
+	c.streams = c.streams[1:]
+	stream.ctx = ctx
+	stream.last = len(c.streams) == 0
+	return stream, nil
+}
+
+func TestWatchResubscribes(t *testing.T) {
+	replay := func(id topoapi.ID) topoapi.Event {
+		return topoapi.Event{Type: topoapi.EventType_NONE, Object: topoapi.Object{ID: id}}
+	}
+	client := &watchClient{
+		streams: []*watchStream{
+			{events: []topoapi.Event{replay("leaf1")}},
+			{events: []topoapi.Event{replay("leaf1"), replay("leaf2")}},
+		},
+		lost: make(chan int, 2),
+	}
+	store := &topoClient{client: client}
+	client.store = store
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	ch := make(chan topoapi.Event)
+	assert.NoError(t, store.Watch(ctx, ch, nil))
+	assert.Equal(t, topoapi.ID("leaf1"), (<-ch).Object.ID)
+
+	// the broken watch resumes with a full replay, counting as lost until re-established
+	assert.Equal(t, topoapi.ID("leaf1"), (<-ch).Object.ID)
+	assert.Equal(t, topoapi.ID("leaf2"), (<-ch).Object.ID)
+	assert.Equal(t, 0, <-client.lost)
+	assert.Equal(t, 1, <-client.lost)
+	assert.Equal(t, 0, store.LostWatches())
+
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func TestWatchUntracked(t *testing.T) {
+	client := &watchClient{
+		streams: []*watchStream{{}, {}},
+		lost:    make(chan int, 2),
+	}
+	store := &topoClient{client: client}
+	client.store = store
+
+	// the broken watch of a northbound client is re-established without counting as lost
+	ctx, cancel := context.WithCancel(context.TODO())
+	ch := make(chan topoapi.Event)
+	assert.NoError(t, store.WatchUntracked(ctx, ch, nil))
+	assert.Equal(t, 0, <-client.lost)
+	assert.Equal(t, 0, <-client.lost)
+
+	cancel()
+	_, ok := <-ch
+	assert.False(t, ok)
+}
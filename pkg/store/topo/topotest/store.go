@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package topotest provides an in-memory topology store for testing the provisioner components
+package topotest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gogo/protobuf/types"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// watchBuffer is the number of events buffered for each watch
+const watchBuffer = 1024
+
+// Store is an in-memory topology store; it implements the object revisions, the object type, label and aspect
+// filters and the watch replay in the manner of onos-topo
+type Store struct {
+	mu       sync.RWMutex
+	objects  map[topoapi.ID]*topoapi.Object
+	revision topoapi.Revision
+	watches  map[*watch]bool
+}
+
+type watch struct {
+	filters *topoapi.Filters
+	ch      chan topoapi.Event
+}
+
+// NewStore returns an in-memory topology store holding the given objects
+func NewStore(objects ...*topoapi.Object) *Store {
+	s := &Store{objects: make(map[topoapi.ID]*topoapi.Object), watches: make(map[*watch]bool)}
+	for _, object := range objects {
+		s.revision++
+		object = clone(object)
+		object.Revision = s.revision
+		s.objects[object.ID] = object
+	}
+	return s
+}
+
+// Create creates a topology object
+func (s *Store) Create(ctx context.Context, object *topoapi.Object) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.objects[object.ID]; ok {
+		return errors.NewAlreadyExists("object %s already exists", object.ID)
+	}
+	s.put(object, topoapi.EventType_ADDED)
+	return nil
+}
+
+// Update updates an existing topology object, provided it has the revision of the stored object
+func (s *Store) Update(ctx context.Context, object *topoapi.Object) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.objects[object.ID]
+	if !ok {
+		return errors.NewNotFound("object %s not found", object.ID)
+	}
+	if object.Revision != stored.Revision {
+		return errors.NewConflict("object %s revision %d is not the current revision %d", object.ID, object.Revision, stored.Revision)
+	}
+	s.put(object, topoapi.EventType_UPDATED)
+	return nil
+}
+
+// Stores the object as a new revision and notifies the watches
+func (s *Store) put(object *topoapi.Object, eventType topoapi.EventType) {
+	s.revision++
+	object.Revision = s.revision
+	s.objects[object.ID] = clone(object)
+	s.notify(eventType, object)
+}
+
+// Get gets a topology object
+func (s *Store) Get(ctx context.Context, id topoapi.ID) (*topoapi.Object, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	object, ok := s.objects[id]
+	if !ok {
+		return nil, errors.NewNotFound("object %s not found", id)
+	}
+	return clone(object), nil
+}
+
+// Query streams the objects matching the filters to the given channel
+func (s *Store) Query(ctx context.Context, ch chan<- *topoapi.Object, filters *topoapi.Filters) error {
+	s.mu.RLock()
+	objects := make([]*topoapi.Object, 0, len(s.objects))
+	for _, object := range s.objects {
+		if matches(object, filters) {
+			objects = append(objects, clone(object))
+		}
+	}
+	s.mu.RUnlock()
+	go func() {
+		defer close(ch)
+		for _, object := range objects {
+			select {
+			case ch <- object:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Delete deletes a topology object
+func (s *Store) Delete(ctx context.Context, object *topoapi.Object) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.objects[object.ID]
+	if !ok {
+		return errors.NewNotFound("object %s not found", object.ID)
+	}
+	delete(s.objects, object.ID)
+	s.notify(topoapi.EventType_REMOVED, stored)
+	return nil
+}
+
+// Watch streams the events of the objects matching the filters, starting with the replay of the existing objects;
+// the channel is closed once the context is done
+func (s *Store) Watch(ctx context.Context, ch chan<- topoapi.Event, filters *topoapi.Filters) error {
+	w := &watch{filters: filters, ch: make(chan topoapi.Event, watchBuffer)}
+	s.mu.Lock()
+	for _, object := range s.objects {
+		if matches(object, filters) {
+			w.ch <- topoapi.Event{Type: topoapi.EventType_NONE, Object: *clone(object)}
+		}
+	}
+	s.watches[w] = true
+	s.mu.Unlock()
+
+	go func() {
+		defer close(ch)
+		defer func() {
+			s.mu.Lock()
+			delete(s.watches, w)
+			s.mu.Unlock()
+		}()
+		for {
+			select {
+			case event := <-w.ch:
+				select {
+				case ch <- event:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// WatchUntracked watches topology events like Watch, as watches are never lost for the in-memory store
+func (s *Store) WatchUntracked(ctx context.Context, ch chan<- topoapi.Event, filters *topoapi.Filters) error {
+	return s.Watch(ctx, ch, filters)
+}
+
+// LostWatches returns the number of lost watches, which is always 0 for the in-memory store
+func (s *Store) LostWatches() int {
+	return 0
+}
+
+// Close closes the store
+func (s *Store) Close() error {
+	return nil
+}
+
+// Sends the event of the object to the watches whose filters it matches
+func (s *Store) notify(eventType topoapi.EventType, object *topoapi.Object) {
+	for w := range s.watches {
+		if matches(object, w.filters) {
+			w.ch <- topoapi.Event{Type: eventType, Object: *clone(object)}
+		}
+	}
+}
+
+// Returns a copy of the object whose aspects and labels can be changed without affecting the original
+func clone(object *topoapi.Object) *topoapi.Object {
+	c := *object
+	c.Aspects = make(map[string]*types.Any, len(object.Aspects))
+	for aspectType, aspect := range object.Aspects {
+		c.Aspects[aspectType] = &types.Any{TypeUrl: aspect.TypeUrl, Value: append([]byte(nil), aspect.Value...)}
+	}
+	c.Labels = make(map[string]string, len(object.Labels))
+	for label, value := range object.Labels {
+		c.Labels[label] = value
+	}
+	return &c
+}
+
+// Returns true if the object matches the object type, label and aspect filters
+func matches(object *topoapi.Object, filters *topoapi.Filters) bool {
+	if filters == nil {
+		return true
+	}
+	if len(filters.ObjectTypes) > 0 {
+		found := false
+		for _, objectType := range filters.ObjectTypes {
+			found = found || objectType == object.Type
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, filter := range filters.LabelFilters {
+		value, ok := object.Labels[filter.Key]
+		switch f := filter.Filter.(type) {
+		case *topoapi.Filter_Equal_:
+			if !ok || value != f.Equal_.Value {
+				return false
+			}
+		case *topoapi.Filter_In:
+			found := false
+			for _, v := range f.In.Values {
+				found = found || ok && v == value
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	for _, aspectType := range filters.WithAspects {
+		if _, ok := object.Aspects[aspectType]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/controller/v2"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Reconciler returns a reconciler which traces each reconcile of a target by the given reconciler of the given
+// controller; the spans of the calls made while reconciling are children of the reconcile span
+func Reconciler(name string, reconciler controller.Reconciler[topoapi.ID]) controller.Reconciler[topoapi.ID] {
+	return func(ctx context.Context, request controller.Request[topoapi.ID]) controller.Directive[topoapi.ID] {
+		ctx, span := Start(ctx, name+".reconcile", request.ID, attribute.String("onos.controller", name))
+		directive := reconciler(ctx, request)
+		End(span, directiveError(directive))
+		return directive
+	}
+}
+
+// Returns the error with which the reconcile failed, if any, as given by its directive
+func directiveError(directive controller.Directive[topoapi.ID]) error {
+	switch d := directive.(type) {
+	case *controller.Fail[topoapi.ID]:
+		return d.Error
+	case *controller.Retry[topoapi.ID]:
+		return d.Error
+	case *controller.RetryAfter[topoapi.ID]:
+		return d.Error
+	case *controller.RetryAt[topoapi.ID]:
+		return d.Error
+	case *controller.RetryWith[topoapi.ID]:
+		return d.Error
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing contains the OpenTelemetry tracing of the provisioner and the export of the traces via OTLP
+package tracing
+
+import (
+	"context"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var log = logging.GetLogger()
+
+const (
+	serviceName         = "device-provisioner"
+	instrumentationName = "github.com/onosproject/device-provisioner"
+)
+
+// TargetIDKey is the key of the span attribute holding the ID of the target topo entity
+const TargetIDKey = attribute.Key("onos.topo.target_id")
+
+// Options are the options of the export of the traces
+type Options struct {
+	// Endpoint is the address:port of the OTLP gRPC collector to which the traces are exported;
+	// tracing is disabled if empty
+	Endpoint string
+	// Insecure disables TLS for the connection to the collector
+	Insecure bool
+}
+
+// NewProvider returns a tracer provider exporting the traces to the OTLP collector, which is also installed as
+// the global tracer provider; returns nil if no collector endpoint is given, in which case the spans are discarded.
+// The provider must be shut down to flush the spans which were not exported yet.
+func NewProvider(ctx context.Context, options Options) (*sdktrace.TracerProvider, error) {
+	if options.Endpoint == "" {
+		return nil, nil
+	}
+	clientOptions := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(options.Endpoint)}
+	if options.Insecure {
+		clientOptions = append(clientOptions, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, clientOptions...)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	log.Infof("Exporting traces to %s", options.Endpoint)
+	return provider, nil
+}
+
+// Start starts a span with the given name as a child of the span in the context, if any; the target ID attribute
+// is set unless the target ID is empty
+func Start(ctx context.Context, name string, targetID topoapi.ID, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	if targetID != "" {
+		attributes = append(attributes, TargetIDKey.String(string(targetID)))
+	}
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End ends the span, recording the error, if any
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
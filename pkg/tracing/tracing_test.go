@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/controller/v2"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+)
+
+// collector is an in-process OTLP trace collector
+type collector struct {
+	coltracepb.UnimplementedTraceServiceServer
+	mu    sync.Mutex
+	spans map[string]*tracepb.Span
+}
+
+func (c *collector) Export(ctx context.Context, request *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, resourceSpans := range request.ResourceSpans {
+		for _, scopeSpans := range resourceSpans.ScopeSpans {
+			for _, span := range scopeSpans.Spans {
+				c.spans[span.Name] = span
+			}
+		}
+	}
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+func startCollector(t *testing.T) (*collector, string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	c := &collector{spans: make(map[string]*tracepb.Span)}
+	server := grpc.NewServer()
+	coltracepb.RegisterTraceServiceServer(server, c)
+	go func() { _ = server.Serve(lis) }()
+	return c, lis.Addr().String(), server.Stop
+}
+
+func stringAttribute(span *tracepb.Span, key string) string {
+	for _, kv := range span.Attributes {
+		if kv.Key == key {
+			return kv.Value.GetStringValue()
+		}
+	}
+	return ""
+}
+
+func TestExport(t *testing.T) {
+	c, endpoint, stop := startCollector(t)
+	defer stop()
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	ctx := context.Background()
+	provider, err := NewProvider(ctx, Options{})
+	assert.NoError(t, err)
+	assert.Nil(t, provider)
+
+	provider, err = NewProvider(ctx, Options{Endpoint: endpoint, Insecure: true})
+	assert.NoError(t, err)
+
+	reconciler := Reconciler("pipeline", func(ctx context.Context, request controller.Request[topoapi.ID]) controller.Directive[topoapi.ID] {
+		_, span := Start(ctx, "SetForwardingPipelineConfig", request.ID)
+		err := errors.NewUnavailable("device is unavailable")
+		End(span, err)
+		return request.Retry(err)
+	})
+	reconciler(ctx, controller.Request[topoapi.ID]{ID: "leaf1"})
+	assert.NoError(t, provider.Shutdown(ctx))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	reconcile, ok := c.spans["pipeline.reconcile"]
+	if !assert.True(t, ok) {
+		return
+	}
+	set, ok := c.spans["SetForwardingPipelineConfig"]
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, "leaf1", stringAttribute(reconcile, string(TargetIDKey)))
+	assert.Equal(t, "leaf1", stringAttribute(set, string(TargetIDKey)))
+	assert.Equal(t, reconcile.TraceId, set.TraceId)
+	assert.Equal(t, reconcile.SpanId, set.ParentSpanId)
+	assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, reconcile.Status.Code)
+	assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, set.Status.Code)
+}